@@ -100,13 +100,17 @@ func main() {
 	// Endpoints
 	v1 := app.Group("/api/v1")
 
-	// Endpoint: GET /api/v1/person
+	// Person endpoints
 	v1.Get("/person", handlers.PersonHandler)
+	v1.Get("/household", handlers.HouseholdHandler)
 
 	// Document endpoints
 	v1.Get("/cpf", handlers.CPFHandler)
 	v1.Get("/cnpj", handlers.CNPJHandler)
 	v1.Get("/rg", handlers.RGHandler)
+	v1.Get("/certificate", handlers.CertificateHandler)
+	v1.Get("/passport", handlers.PassportHandler)
+	v1.Get("/council-registration", handlers.CouncilRegistrationHandler)
 
 	// Contact endpoints
 	v1.Get("/email", handlers.EmailHandler)
@@ -118,16 +122,36 @@ func main() {
 
 	// Address endpoints
 	v1.Get("/address", handlers.AddressHandler)
+	v1.Get("/address/near", handlers.NearbyAddressesHandler)
+	v1.Get("/address/reverse", handlers.ReverseGeocodeHandler)
+	v1.Get("/address/autocomplete", handlers.AddressAutocompleteHandler)
 	v1.Get("/zipcode", handlers.ZipcodeHandler)
+	v1.Get("/zipcode/:cep", handlers.ZipcodeLookupHandler)
 
-	// Company endpoint
+	// Geography endpoints
+	v1.Get("/states", handlers.StatesHandler)
+	v1.Get("/states/:uf/cities", handlers.CitiesHandler)
+	v1.Get("/states/:uf/cities/:city/districts", handlers.DistrictsHandler)
+	v1.Get("/states/:uf/cities/:city/streets", handlers.StreetsHandler)
+
+	// Company endpoints
 	v1.Get("/company", handlers.CompanyHandler)
+	v1.Get("/company-group", handlers.CompanyGroupHandler)
+	v1.Get("/company/:cnpj/employees", handlers.CompanyEmployeesHandler)
+	v1.Get("/mei", handlers.MEIHandler)
 
 	// Validation endpoints
 	v1.Get("/validate/cpf/:cpf", handlers.ValidateCPFHandler)
 	v1.Get("/validate/cnpj/:cnpj", handlers.ValidateCNPJHandler)
 	v1.Get("/validate/rg/:rg", handlers.ValidateRGHandler)
+	v1.Get("/validate/certificate/:number", handlers.ValidateCertificateHandler)
+	v1.Get("/validate/passport/:passport", handlers.ValidatePassportHandler)
+	v1.Get("/validate/council-registration", handlers.ValidateCouncilRegistrationHandler)
 	v1.Get("/validate/phone", handlers.ValidatePhone)
+	v1.Post("/validate/address", handlers.ValidateAddressHandler)
+
+	// ViaCEP-compatible CEP lookup
+	app.Get("/ws/:cep/json", handlers.ViaCEPHandler)
 
 	// Health check
 	app.Get("/api/health", func(c *fiber.Ctx) error {
@@ -3,6 +3,7 @@ package generators
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -10,16 +11,20 @@ import (
 )
 
 // GenerateAddress generates a Brazilian address using the real database
+// The street includes its type of logradouro (ex: Avenida Ceará) and the address comes with its usual formats
+// The city restricts the address to one of its streets, ignoring case and accents; unknown cities are
+// ignored, use DataStore.FindCity to reject them
 func (g *Generator) GenerateAddress(stateCode, city string) *models.Address {
 	ds := g.dataStore
+	stateCode, city = g.resolveCity(stateCode, city)
 
 	maxRetries := 10
 	var realAddr *RealAddress
 	var street, neighborhood string
 
 	for i := 0; i < maxRetries; i++ {
-		realAddr = ds.GetRandomRealAddress(stateCode)
-		street = strings.TrimSpace(realAddr.Name)
+		realAddr = g.pickRealAddress(stateCode, city)
+		street = fullStreetName(*realAddr)
 		neighborhood = strings.TrimSpace(realAddr.District)
 
 		if len(street) >= 3 && len(neighborhood) >= 3 {
@@ -80,19 +85,66 @@ func (g *Generator) GenerateAddress(stateCode, city string) *models.Address {
 		}
 	}
 
-	return &models.Address{
-		Street:       street,
-		Number:       number,
-		Complement:   complement,
-		Neighborhood: neighborhood,
-		City:         cityName,
-		State:        stateCodeFinal,
-		Zipcode:      zipcode,
+	address := &models.Address{
+		Street:            street,
+		StreetAbbreviated: abbreviateStreetName(street),
+		Number:            number,
+		Complement:        complement,
+		Neighborhood:      neighborhood,
+		City:              cityName,
+		IBGECode:          ds.GetCityIBGECode(stateCodeFinal, cityName),
+		State:             stateCodeFinal,
+		Zipcode:           zipcode,
 		Coordinates: &models.Coordinates{
 			Lat: lat,
 			Lng: lng,
 		},
 	}
+	address.Formatted = FormatAddress(address)
+
+	return address
+}
+
+// LookupZipcode looks up a CEP, with or without mask, in the real address database
+// It returns nil when the CEP is unknown
+func (g *Generator) LookupZipcode(cep string) *models.ZipcodeLookupResponse {
+	record := g.dataStore.LookupZipcode(cep)
+	if record == nil {
+		return nil
+	}
+
+	response := &models.ZipcodeLookupResponse{
+		Zipcode:      formatCEP(record.CEP),
+		Unformatted:  onlyDigits(record.CEP),
+		Street:       fullStreetName(record.RealAddress),
+		AddressType:  record.AddressType,
+		Neighborhood: strings.TrimSpace(record.District),
+		City:         record.City,
+		IBGECode:     record.CityIBGECode,
+		State:        record.StateCode,
+		StateName:    record.State,
+		DDD:          record.CityDDD,
+	}
+	if state := g.dataStore.GetStateByCode(record.StateCode); state != nil {
+		response.Region = state.Region
+	}
+	if record.Latitude != "" && record.Longitude != "" {
+		lat, lng := parseCoordinates(record.Latitude, record.Longitude)
+		response.Coordinates = &models.Coordinates{Lat: lat, Lng: lng}
+	}
+
+	return response
+}
+
+// fullStreetName joins the type (logradouro) and the name of a street (ex: Avenida Ceará)
+// Names that already start with the type are kept as they are
+func fullStreetName(address RealAddress) string {
+	name := strings.TrimSpace(address.Name)
+	addressType := strings.TrimSpace(address.AddressType)
+	if addressType == "" || strings.HasPrefix(name, addressType+" ") || name == addressType {
+		return name
+	}
+	return strings.TrimSpace(addressType + " " + name)
 }
 
 // formatCEP formats the CEP in the XXXXX-XXX format
@@ -158,6 +210,14 @@ func getStateCodeFromName(stateName string) string {
 	return "AC"
 }
 
+// cepPattern matches a CEP with or without the dash (ex: 69918-111 or 69918111)
+var cepPattern = regexp.MustCompile(`^\d{5}-?\d{3}$`)
+
+// ValidateCEP checks if a CEP has the format of 8 digits, with or without the dash
+func ValidateCEP(cep string) bool {
+	return cepPattern.MatchString(strings.TrimSpace(cep))
+}
+
 // GenerateZipcode generates a valid CEP
 func GenerateZipcode() string {
 	firstPart := rand.Intn(100000)
@@ -166,8 +226,10 @@ func GenerateZipcode() string {
 }
 
 // GenerateZipcodeDetails generates all the details of a CEP using real addresses
-func (g *Generator) GenerateZipcodeDetails(stateCode string) (formatted, unformatted, state, city string) {
-	realAddr := g.dataStore.GetRandomRealAddress(stateCode)
+// The city restricts the CEP like in GenerateAddress
+func (g *Generator) GenerateZipcodeDetails(stateCode, cityName string) (formatted, unformatted, state, city string) {
+	stateCode, cityName = g.resolveCity(stateCode, cityName)
+	realAddr := g.pickRealAddress(stateCode, cityName)
 
 	formatted = formatCEP(realAddr.CEP)
 	unformatted = strings.ReplaceAll(formatted, "-", "")
@@ -181,3 +243,25 @@ func (g *Generator) GenerateZipcodeDetails(stateCode string) (formatted, unforma
 
 	return
 }
+
+// resolveCity resolves the requested city to its state and canonical name
+// Unknown cities are dropped, keeping the requested state
+func (g *Generator) resolveCity(stateCode, city string) (string, string) {
+	if city == "" {
+		return stateCode, ""
+	}
+	if state, name, ok := g.dataStore.FindCity(stateCode, city); ok {
+		return state, name
+	}
+	return stateCode, ""
+}
+
+// pickRealAddress picks a random real address of the city, or of the state when the city is empty
+func (g *Generator) pickRealAddress(stateCode, city string) *RealAddress {
+	if city != "" {
+		if address := g.dataStore.GetRandomRealAddressInCity(stateCode, city); address != nil {
+			return address
+		}
+	}
+	return g.dataStore.GetRandomRealAddress(stateCode)
+}
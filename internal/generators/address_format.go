@@ -0,0 +1,131 @@
+package generators
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// streetTypeAbbreviations maps the types of logradouro to their usual abbreviations
+var streetTypeAbbreviations = map[string]string{
+	"Acampamento": "Acamp.",
+	"Acesso":      "Ac.",
+	"Alameda":     "Al.",
+	"Avenida":     "Av.",
+	"Beco":        "Bc.",
+	"Boulevard":   "Blv.",
+	"Caminho":     "Cam.",
+	"Comunidade":  "Com.",
+	"Conjunto":    "Conj.",
+	"Escadaria":   "Esc.",
+	"Estrada":     "Estr.",
+	"Fazenda":     "Faz.",
+	"Granja":      "Gja.",
+	"Largo":       "Lg.",
+	"Loteamento":  "Lot.",
+	"Passagem":    "Psg.",
+	"Praça":       "Pç.",
+	"Quadra":      "Qd.",
+	"Residencial": "Res.",
+	"Rodovia":     "Rod.",
+	"Rua":         "R.",
+	"Servidão":    "Serv.",
+	"Travessa":    "Tv.",
+	"Via":         "V.",
+	"Viela":       "Vla.",
+	"Vila":        "Vl.",
+}
+
+// complementAbbreviations maps the words of complements to the way users usually type them
+var complementAbbreviations = map[string][]string{
+	"Apto":  {"apto", "ap", "apt"},
+	"Sala":  {"sala", "sl"},
+	"Loja":  {"loja", "lj"},
+	"Bloco": {"bloco", "bl"},
+}
+
+// abbreviateStreetName abbreviates the type of logradouro of a full street name (ex: Avenida Ceará -> Av. Ceará)
+// The type may follow an ordinal (ex: 1ª Travessa São Miguel -> 1ª Tv. São Miguel); other names are kept as they are
+func abbreviateStreetName(street string) string {
+	words := strings.Fields(street)
+	for i := 0; i < len(words) && i < 2; i++ {
+		if abbreviation, ok := streetTypeAbbreviations[words[i]]; ok && len(words) > i+1 {
+			words[i] = abbreviation
+			return strings.Join(words, " ")
+		}
+	}
+	return street
+}
+
+// FormatAddress writes the address in a single line, as a multi-line Correios label and as users would type it
+// The label ends with the CEP line in the Correios format (ex: CEP 69918-111 Rio Branco/AC)
+func FormatAddress(address *models.Address) *models.AddressFormats {
+	streetLine := fmt.Sprintf("%s, %s", address.Street, address.Number)
+	if address.Complement != "" {
+		streetLine += " - " + address.Complement
+	}
+
+	singleLine := fmt.Sprintf("%s, %s", address.Street, address.Number)
+	if address.Complement != "" {
+		singleLine += ", " + address.Complement
+	}
+	singleLine += fmt.Sprintf(" - %s, %s - %s, %s", address.Neighborhood, address.City, address.State, address.Zipcode)
+
+	label := strings.Join([]string{
+		streetLine,
+		address.Neighborhood,
+		fmt.Sprintf("CEP %s %s/%s", address.Zipcode, address.City, address.State),
+	}, "\n")
+
+	return &models.AddressFormats{
+		SingleLine: singleLine,
+		Label:      label,
+		Typed:      typeAddress(address),
+	}
+}
+
+// typeAddress writes the address the way users type it in free-text fields: abbreviated street type, optional
+// accents, dots and capitalization, and the CEP with or without the dash
+func typeAddress(address *models.Address) string {
+	street := address.Street
+	if rand.Intn(2) == 0 {
+		street = abbreviateStreetName(street)
+	}
+
+	parts := []string{street, address.Number}
+	if address.Complement != "" {
+		parts = append(parts, typeComplement(address.Complement))
+	}
+	parts = append(parts, address.Neighborhood, address.City, address.State)
+
+	zipcode := address.Zipcode
+	if rand.Intn(2) == 0 {
+		zipcode = strings.ReplaceAll(zipcode, "-", "")
+	}
+	parts = append(parts, zipcode)
+
+	separators := []string{", ", " ", " - "}
+	typed := strings.Join(parts, separators[rand.Intn(len(separators))])
+
+	if rand.Intn(2) == 0 {
+		typed = removeAccents(typed)
+	}
+	if rand.Intn(2) == 0 {
+		typed = strings.ReplaceAll(typed, ".", "")
+	}
+	if rand.Intn(3) > 0 {
+		typed = strings.ToLower(typed)
+	}
+	return typed
+}
+
+// typeComplement abbreviates the first word of a complement like users do (ex: Apto 101 -> ap 101)
+func typeComplement(complement string) string {
+	words := strings.Fields(complement)
+	if variants, ok := complementAbbreviations[words[0]]; ok {
+		words[0] = variants[rand.Intn(len(variants))]
+	}
+	return strings.Join(words, " ")
+}
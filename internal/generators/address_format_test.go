@@ -0,0 +1,99 @@
+package generators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for the address formats focusing on:
+// 1. Abbreviation of the types of logradouro
+// 2. Single-line and Correios label formats
+// 3. Unstructured address as typed by users
+
+func TestAbbreviateStreetName(t *testing.T) {
+	tests := []struct {
+		street   string
+		expected string
+	}{
+		{"Avenida Ceará", "Av. Ceará"},
+		{"Rua Padre André", "R. Padre André"},
+		{"1ª Travessa São Miguel", "1ª Tv. São Miguel"},
+		{"Praça da Sé", "Pç. da Sé"},
+		{"Quadra 104 Conjunto 5", "Qd. 104 Conjunto 5"},
+		{"Avenida", "Avenida"},
+		{"Santa Emília", "Santa Emília"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, abbreviateStreetName(tt.street))
+	}
+}
+
+func TestFormatAddress(t *testing.T) {
+	address := &models.Address{
+		Street:       "Avenida Ceará",
+		Number:       "1234",
+		Complement:   "Apto 101",
+		Neighborhood: "7º BEC",
+		City:         "Rio Branco",
+		State:        "AC",
+		Zipcode:      "69918-111",
+	}
+
+	formats := FormatAddress(address)
+
+	assert.Equal(t, "Avenida Ceará, 1234, Apto 101 - 7º BEC, Rio Branco - AC, 69918-111", formats.SingleLine)
+	assert.Equal(t, "Avenida Ceará, 1234 - Apto 101\n7º BEC\nCEP 69918-111 Rio Branco/AC", formats.Label)
+
+	address.Complement = ""
+	formats = FormatAddress(address)
+	assert.Equal(t, "Avenida Ceará, 1234 - 7º BEC, Rio Branco - AC, 69918-111", formats.SingleLine)
+	assert.Equal(t, "Avenida Ceará, 1234\n7º BEC\nCEP 69918-111 Rio Branco/AC", formats.Label)
+}
+
+func TestFormatAddress_Typed(t *testing.T) {
+	address := &models.Address{
+		Street:       "Avenida Ceará",
+		Number:       "1234",
+		Complement:   "Apto 101",
+		Neighborhood: "Centro",
+		City:         "Rio Branco",
+		State:        "AC",
+		Zipcode:      "69918-111",
+	}
+
+	variants := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		typed := FormatAddress(address).Typed
+		variants[typed] = true
+
+		normalized := strings.ToLower(removeAccents(typed))
+		assert.NotContains(t, typed, "\n")
+		assert.Contains(t, normalized, "1234")
+		assert.Contains(t, normalized, "rio branco")
+		assert.Regexp(t, `69918-?111`, typed)
+		assert.Regexp(t, `^(avenida|av\.?) ceara`, normalized)
+		assert.Regexp(t, `(apto|ap|apt) 101`, normalized)
+	}
+	assert.Greater(t, len(variants), 10, "Typed addresses should vary")
+}
+
+func TestGenerateAddress_StreetType(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		address := gen.GenerateAddress("AC", "")
+		record := gen.dataStore.LookupZipcode(address.Zipcode)
+		if assert.NotNil(t, record) {
+			assert.Equal(t, fullStreetName(record.RealAddress), address.Street)
+		}
+		assert.Equal(t, abbreviateStreetName(address.Street), address.StreetAbbreviated)
+		if assert.NotNil(t, address.Formatted) {
+			assert.True(t, strings.HasSuffix(address.Formatted.Label, "CEP "+address.Zipcode+" Rio Branco/AC"))
+			assert.True(t, strings.HasPrefix(address.Formatted.SingleLine, address.Street+", "+address.Number))
+		}
+	}
+}
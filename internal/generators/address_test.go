@@ -11,6 +11,8 @@ import (
 // 2. State-specific generation
 // 3. Street variability
 // 4. Coordinate validation
+// 5. City restriction ignoring case and accents
+// 6. CEP lookup in the real address database
 
 func TestGenerateAddress_Complete(t *testing.T) {
 	ds, err := NewDataStore()
@@ -81,3 +83,111 @@ func TestGenerateAddress_ZipcodeFormat(t *testing.T) {
 	assert.Contains(t, address.Zipcode, "-", "Zipcode should contain dash")
 }
 
+
+func TestGenerateAddress_City(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for _, city := range []string{"João Pessoa", "joao pessoa", "  JOÃO   PESSOA "} {
+		for i := 0; i < 20; i++ {
+			address := gen.GenerateAddress("PB", city)
+			assert.Equal(t, "João Pessoa", address.City)
+			assert.Equal(t, "PB", address.State)
+		}
+	}
+
+	// Without a state, the state of the city is used
+	address := gen.GenerateAddress("", "belo horizonte")
+	assert.Equal(t, "Belo Horizonte", address.City)
+	assert.Equal(t, "MG", address.State)
+
+	// Cities missing in the state are ignored
+	address = gen.GenerateAddress("SP", "Belo Horizonte")
+	assert.Equal(t, "SP", address.State)
+	assert.NotEqual(t, "Belo Horizonte", address.City)
+}
+
+func TestGenerateZipcodeDetails_City(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 20; i++ {
+		formatted, _, state, city := gen.GenerateZipcodeDetails("", "brasília")
+		assert.Equal(t, "DF", state)
+		assert.Equal(t, "Brasilia", city)
+		assert.Regexp(t, `^\d{5}-\d{3}$`, formatted)
+	}
+}
+
+func TestFindCity(t *testing.T) {
+	gen := newTestGenerator(t)
+	ds := gen.dataStore
+
+	state, city, ok := ds.FindCity("ce", "EUSEBIO")
+	assert.True(t, ok)
+	assert.Equal(t, "CE", state)
+	assert.Equal(t, "Eusébio", city)
+
+	state, city, ok = ds.FindCity("", "São Luís")
+	assert.True(t, ok)
+	assert.Equal(t, "MA", state)
+	assert.Equal(t, "São Luís", city)
+
+	_, _, ok = ds.FindCity("RJ", "Fortaleza")
+	assert.False(t, ok, "Fortaleza is not in RJ")
+
+	_, _, ok = ds.FindCity("", "Cidade Inexistente")
+	assert.False(t, ok)
+}
+
+func TestLookupZipcode(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for _, cep := range []string{"13860025", "13860-025", " 13860-025 "} {
+		address := gen.LookupZipcode(cep)
+		if assert.NotNil(t, address, cep) {
+			assert.Equal(t, "13860-025", address.Zipcode)
+			assert.Equal(t, "13860025", address.Unformatted)
+			assert.Equal(t, "Avenida Doutor Leonardo Guaranha", address.Street)
+			assert.Equal(t, "Avenida", address.AddressType)
+			assert.Equal(t, "Centro", address.Neighborhood)
+			assert.Equal(t, "Aguaí", address.City)
+			assert.Equal(t, "3500303", address.IBGECode)
+			assert.Equal(t, "SP", address.State)
+			assert.Equal(t, "São Paulo", address.StateName)
+			assert.Equal(t, "Sudeste", address.Region)
+			assert.Equal(t, "19", address.DDD)
+			if assert.NotNil(t, address.Coordinates) {
+				assert.InDelta(t, -22.05529, address.Coordinates.Lat, 0.00001)
+				assert.InDelta(t, -46.98079, address.Coordinates.Lng, 0.00001)
+			}
+		}
+	}
+
+	assert.Nil(t, gen.LookupZipcode("00000000"))
+}
+
+func TestLookupZipcode_GeneratedAddresses(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		address := gen.GenerateAddress("", "")
+		found := gen.LookupZipcode(address.Zipcode)
+		if assert.NotNil(t, found, address.Zipcode) {
+			assert.Equal(t, address.City, found.City)
+			assert.Equal(t, address.State, found.State)
+		}
+	}
+}
+
+func TestFullStreetName(t *testing.T) {
+	assert.Equal(t, "Avenida Ceará", fullStreetName(RealAddress{AddressType: "Avenida", Name: "Ceará"}))
+	assert.Equal(t, "Quadra 12", fullStreetName(RealAddress{AddressType: "Quadra", Name: "Quadra 12"}))
+	assert.Equal(t, "Sem Tipo", fullStreetName(RealAddress{Name: " Sem Tipo "}))
+}
+
+func TestValidateCEP(t *testing.T) {
+	assert.True(t, ValidateCEP("69918-111"))
+	assert.True(t, ValidateCEP("69918111"))
+	assert.False(t, ValidateCEP("69918-11"))
+	assert.False(t, ValidateCEP("6991a111"))
+	assert.False(t, ValidateCEP(""))
+}
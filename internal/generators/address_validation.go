@@ -0,0 +1,217 @@
+package generators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Reason codes of the address validation findings
+const (
+	AddressFindingOK            = "ok"
+	AddressFindingMissing       = "missing"
+	AddressFindingInvalidFormat = "invalid_format"
+	AddressFindingUnknownState  = "unknown_state"
+	AddressFindingUnknownRange  = "unknown_range"
+	AddressFindingStateMismatch = "state_mismatch"
+	AddressFindingCityMismatch  = "city_mismatch"
+	AddressFindingCityNotFound  = "city_not_found"
+	AddressFindingDDDMismatch   = "ddd_mismatch"
+	// AddressFindingUnchecked marks fields that depend on another field that is invalid
+	AddressFindingUnchecked = "unchecked"
+)
+
+// cepRange is a range of the first 5 digits of the CEPs of a state
+type cepRange struct {
+	first, last int
+}
+
+// cepRanges are the ranges of CEP prefixes assigned by the Correios to each state
+var cepRanges = map[string][]cepRange{
+	"SP": {{1000, 19999}},
+	"RJ": {{20000, 28999}},
+	"ES": {{29000, 29999}},
+	"MG": {{30000, 39999}},
+	"BA": {{40000, 48999}},
+	"SE": {{49000, 49999}},
+	"PE": {{50000, 56999}},
+	"AL": {{57000, 57999}},
+	"PB": {{58000, 58999}},
+	"RN": {{59000, 59999}},
+	"CE": {{60000, 63999}},
+	"PI": {{64000, 64999}},
+	"MA": {{65000, 65999}},
+	"PA": {{66000, 68899}},
+	"AP": {{68900, 68999}},
+	"AM": {{69000, 69299}, {69400, 69899}},
+	"RR": {{69300, 69399}},
+	"AC": {{69900, 69999}},
+	"DF": {{70000, 72799}, {73000, 73699}},
+	"GO": {{72800, 72999}, {73700, 76799}},
+	"RO": {{76800, 76999}, {78900, 78999}},
+	"TO": {{77000, 77999}},
+	"MT": {{78000, 78899}},
+	"MS": {{79000, 79999}},
+	"PR": {{80000, 87999}},
+	"SC": {{88000, 89999}},
+	"RS": {{90000, 99999}},
+}
+
+// StateForCEP returns the code of the state whose CEP range contains the CEP, or an empty string when
+// the CEP is malformed or its prefix is not assigned to any state
+func StateForCEP(cep string) string {
+	if !ValidateCEP(cep) {
+		return ""
+	}
+	prefix, _ := strconv.Atoi(onlyDigits(cep)[:5])
+	for state, ranges := range cepRanges {
+		for _, r := range ranges {
+			if prefix >= r.first && prefix <= r.last {
+				return state
+			}
+		}
+	}
+	return ""
+}
+
+// ValidateAddress checks that the CEP, city, state and optional phone of an address agree with each other
+// The CEP must be in the range of the state (and in the city when the CEP is in the database), the city must
+// be one of the cities of the state in the database and the DDD of the phone must be the DDD of the city, or
+// one of the DDDs of the state when the city is unknown
+func (g *Generator) ValidateAddress(req models.AddressValidationRequest) *models.AddressValidationResponse {
+	ds := g.dataStore
+
+	var state *StateData
+	if strings.TrimSpace(req.State) != "" {
+		state = ds.GetStateByCode(strings.TrimSpace(req.State))
+	}
+	city, cityFound := "", false
+	if state != nil && strings.TrimSpace(req.City) != "" {
+		_, city, cityFound = ds.FindCity(state.Code, strings.TrimSpace(req.City))
+	}
+
+	findings := []models.AddressValidationFinding{
+		g.checkZipcode(req, state),
+		checkState(req, state),
+		checkCity(req, state, city, cityFound),
+	}
+	if strings.TrimSpace(req.Phone) != "" {
+		findings = append(findings, g.checkPhoneDDD(req, state, city, cityFound))
+	}
+
+	valid := true
+	for _, finding := range findings {
+		valid = valid && finding.Valid
+	}
+
+	return &models.AddressValidationResponse{
+		Valid:    valid,
+		Findings: findings,
+	}
+}
+
+// checkZipcode checks the format and the range of the CEP, and its city when the CEP is in the database
+func (g *Generator) checkZipcode(req models.AddressValidationRequest, state *StateData) models.AddressValidationFinding {
+	cep := strings.TrimSpace(req.Zipcode)
+	switch {
+	case cep == "":
+		return addressFinding("zipcode", AddressFindingMissing, "zipcode is required")
+	case !ValidateCEP(cep):
+		return addressFinding("zipcode", AddressFindingInvalidFormat, "zipcode must have 8 digits (ex: 69918-111 or 69918111)")
+	}
+
+	cepState := StateForCEP(cep)
+	if cepState == "" {
+		return addressFinding("zipcode", AddressFindingUnknownRange, "zipcode %s is not in the range of any state", formatCEP(cep))
+	}
+	if state != nil && cepState != state.Code {
+		finding := addressFinding("zipcode", AddressFindingStateMismatch, "zipcode %s belongs to %s, not %s", formatCEP(cep), cepState, state.Code)
+		finding.Expected = cepState
+		return finding
+	}
+
+	city := strings.TrimSpace(req.City)
+	if record := g.dataStore.LookupZipcode(cep); record != nil && city != "" && normalizePlaceName(record.City) != normalizePlaceName(city) {
+		finding := addressFinding("zipcode", AddressFindingCityMismatch, "zipcode %s belongs to %s/%s, not %s", formatCEP(cep), record.City, record.StateCode, city)
+		finding.Expected = record.City
+		return finding
+	}
+
+	return addressFinding("zipcode", AddressFindingOK, "zipcode %s is in the range of %s", formatCEP(cep), cepState)
+}
+
+// checkState checks that the state is a known state code
+func checkState(req models.AddressValidationRequest, state *StateData) models.AddressValidationFinding {
+	switch {
+	case strings.TrimSpace(req.State) == "":
+		return addressFinding("state", AddressFindingMissing, "state is required")
+	case state == nil:
+		return addressFinding("state", AddressFindingUnknownState, "state must be a valid state code (ex: SP, RJ)")
+	}
+	return addressFinding("state", AddressFindingOK, "state %s (%s) is valid", state.Code, state.Name)
+}
+
+// checkCity checks that the city is one of the cities of the state in the database
+// The canonical name of the city is only known when it was found
+func checkCity(req models.AddressValidationRequest, state *StateData, city string, found bool) models.AddressValidationFinding {
+	switch {
+	case strings.TrimSpace(req.City) == "":
+		return addressFinding("city", AddressFindingMissing, "city is required")
+	case state == nil:
+		return addressFinding("city", AddressFindingUnchecked, "city cannot be checked without a valid state")
+	case !found:
+		return addressFinding("city", AddressFindingCityNotFound, "city %q not found in state %s", strings.TrimSpace(req.City), state.Code)
+	}
+	return addressFinding("city", AddressFindingOK, "city %s is in state %s", city, state.Code)
+}
+
+// checkPhoneDDD checks that the DDD of the phone is the DDD of the city, or one of the DDDs of the state
+// when the city is unknown
+func (g *Generator) checkPhoneDDD(req models.AddressValidationRequest, state *StateData, city string, cityFound bool) models.AddressValidationFinding {
+	digits := onlyDigits(req.Phone)
+	// The DDI of Brazil may precede the number
+	if (len(digits) == 12 || len(digits) == 13) && strings.HasPrefix(digits, "55") {
+		digits = digits[2:]
+	}
+	if (len(digits) != 10 && len(digits) != 11) || digits[0] == '0' {
+		return addressFinding("phone", AddressFindingInvalidFormat, "phone must have a DDD and 8 or 9 digits (ex: (68) 99999-9999)")
+	}
+	ddd := digits[:2]
+
+	if state == nil {
+		return addressFinding("phone", AddressFindingUnchecked, "phone DDD cannot be checked without a valid state")
+	}
+
+	if cityFound {
+		if expected, ok := g.dataStore.GetCityDDD(state.Code, city); ok {
+			if ddd != expected {
+				finding := addressFinding("phone", AddressFindingDDDMismatch, "phone DDD %s is not the DDD of %s/%s", ddd, city, state.Code)
+				finding.Expected = expected
+				return finding
+			}
+			return addressFinding("phone", AddressFindingOK, "phone DDD %s is the DDD of %s/%s", ddd, city, state.Code)
+		}
+	}
+
+	ddds := g.dataStore.GetStateDDDs(state.Code)
+	for _, stateDDD := range ddds {
+		if ddd == stateDDD {
+			return addressFinding("phone", AddressFindingOK, "phone DDD %s is a DDD of %s", ddd, state.Code)
+		}
+	}
+	finding := addressFinding("phone", AddressFindingDDDMismatch, "phone DDD %s is not a DDD of %s", ddd, state.Code)
+	finding.Expected = strings.Join(ddds, ", ")
+	return finding
+}
+
+// addressFinding creates a finding of a field, valid when the code is ok
+func addressFinding(field, code, format string, args ...interface{}) models.AddressValidationFinding {
+	return models.AddressValidationFinding{
+		Field:   field,
+		Valid:   code == AddressFindingOK,
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,141 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for the address validation focusing on:
+// 1. CEP ranges of the states
+// 2. Findings of consistent and inconsistent addresses
+// 3. DDD of the phone against the city and the state
+
+func TestStateForCEP(t *testing.T) {
+	tests := []struct {
+		cep      string
+		expected string
+	}{
+		{"01001-000", "SP"},
+		{"69918111", "AC"},
+		{"69301-000", "RR"},
+		{"69400-000", "AM"},
+		{"73100-000", "DF"},
+		{"73700-000", "GO"},
+		{"78900-000", "RO"},
+		{"99999-999", "RS"},
+		{"00999-000", ""},
+		{"1234", ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, StateForCEP(tt.cep), tt.cep)
+	}
+}
+
+func TestStateForCEP_RealAddresses(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for _, state := range gen.dataStore.GetStates() {
+		data := gen.dataStore.GetStateAddressData(state.Code)
+		for _, city := range data.Cities {
+			for _, district := range city.Districts {
+				for _, street := range district.Streets {
+					assert.Equal(t, state.Code, StateForCEP(street.CEP), "CEP %s of %s", street.CEP, city.Name)
+				}
+			}
+		}
+	}
+}
+
+func TestValidateAddress_Consistent(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	response := gen.ValidateAddress(models.AddressValidationRequest{
+		Zipcode: "69918-111",
+		City:    "rio branco",
+		State:   "ac",
+		Phone:   "+55 (68) 99999-1234",
+	})
+
+	assert.True(t, response.Valid)
+	if assert.Len(t, response.Findings, 4) {
+		for i, field := range []string{"zipcode", "state", "city", "phone"} {
+			assert.Equal(t, field, response.Findings[i].Field)
+			assert.Equal(t, AddressFindingOK, response.Findings[i].Code)
+			assert.True(t, response.Findings[i].Valid)
+		}
+	}
+}
+
+func TestValidateAddress_Inconsistent(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	tests := []struct {
+		name     string
+		req      models.AddressValidationRequest
+		field    string
+		code     string
+		expected string
+	}{
+		{"missing zipcode", models.AddressValidationRequest{City: "Rio Branco", State: "AC"}, "zipcode", AddressFindingMissing, ""},
+		{"malformed zipcode", models.AddressValidationRequest{Zipcode: "6991-8111", City: "Rio Branco", State: "AC"}, "zipcode", AddressFindingInvalidFormat, ""},
+		{"unassigned zipcode", models.AddressValidationRequest{Zipcode: "00100-000", City: "Rio Branco", State: "AC"}, "zipcode", AddressFindingUnknownRange, ""},
+		{"zipcode of another state", models.AddressValidationRequest{Zipcode: "01001-000", City: "Rio Branco", State: "AC"}, "zipcode", AddressFindingStateMismatch, "SP"},
+		{"zipcode of another city", models.AddressValidationRequest{Zipcode: "13860-025", City: "Campinas", State: "SP"}, "zipcode", AddressFindingCityMismatch, "Aguaí"},
+		{"missing state", models.AddressValidationRequest{Zipcode: "69918-111", City: "Rio Branco"}, "state", AddressFindingMissing, ""},
+		{"unknown state", models.AddressValidationRequest{Zipcode: "69918-111", City: "Rio Branco", State: "XX"}, "state", AddressFindingUnknownState, ""},
+		{"city without state", models.AddressValidationRequest{Zipcode: "69918-111", City: "Rio Branco", State: "XX"}, "city", AddressFindingUnchecked, ""},
+		{"city of another state", models.AddressValidationRequest{Zipcode: "69918-111", City: "Manaus", State: "AC"}, "city", AddressFindingCityNotFound, ""},
+		{"malformed phone", models.AddressValidationRequest{Zipcode: "69918-111", City: "Rio Branco", State: "AC", Phone: "9999-1234"}, "phone", AddressFindingInvalidFormat, ""},
+		{"phone of another city", models.AddressValidationRequest{Zipcode: "13860-025", City: "Aguaí", State: "SP", Phone: "(11) 3333-4444"}, "phone", AddressFindingDDDMismatch, "19"},
+		{"phone of another state", models.AddressValidationRequest{Zipcode: "69918-111", City: "Xapuri", State: "AC", Phone: "(11) 3333-4444"}, "phone", AddressFindingDDDMismatch, "68"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := gen.ValidateAddress(tt.req)
+
+			assert.False(t, response.Valid)
+			var finding *models.AddressValidationFinding
+			for i := range response.Findings {
+				if response.Findings[i].Field == tt.field {
+					finding = &response.Findings[i]
+				}
+			}
+			if assert.NotNil(t, finding) {
+				assert.Equal(t, tt.code, finding.Code)
+				assert.False(t, finding.Valid)
+				assert.NotEmpty(t, finding.Message)
+				assert.Equal(t, tt.expected, finding.Expected)
+			}
+		})
+	}
+}
+
+func TestValidateAddress_NoPhone(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	response := gen.ValidateAddress(models.AddressValidationRequest{Zipcode: "13860025", City: "AGUAI", State: "SP"})
+
+	assert.True(t, response.Valid)
+	assert.Len(t, response.Findings, 3, "The phone is only checked when present")
+}
+
+func TestValidateAddress_GeneratedAddresses(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		address := gen.GenerateAddress("", "")
+		_, ddd, _, _ := gen.GeneratePhoneInCity(address.State, address.City, "mobile")
+
+		response := gen.ValidateAddress(models.AddressValidationRequest{
+			Zipcode: address.Zipcode,
+			City:    address.City,
+			State:   address.State,
+			Phone:   "(" + ddd + ") 99999-0000",
+		})
+		assert.True(t, response.Valid, "%+v", response.Findings)
+	}
+}
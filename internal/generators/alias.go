@@ -0,0 +1,88 @@
+package generators
+
+import "math/rand"
+
+// aliasTable samples indexes following a discrete weighted distribution in O(1)
+// Built with the Vose alias method
+type aliasTable struct {
+	prob  []float64
+	alias []int
+}
+
+// newAliasTable builds an alias table from the given weights
+// Non-positive weights are never picked; if every weight is non-positive, picks are uniform
+func newAliasTable(weights []float64) *aliasTable {
+	n := len(weights)
+	table := &aliasTable{
+		prob:  make([]float64, n),
+		alias: make([]int, n),
+	}
+	if n == 0 {
+		return table
+	}
+
+	total := 0.0
+	for _, weight := range weights {
+		if weight > 0 {
+			total += weight
+		}
+	}
+
+	scaled := make([]float64, n)
+	for i, weight := range weights {
+		switch {
+		case total == 0:
+			scaled[i] = 1
+		case weight > 0:
+			scaled[i] = weight * float64(n) / total
+		}
+	}
+
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, value := range scaled {
+		if value < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s := small[len(small)-1]
+		small = small[:len(small)-1]
+		l := large[len(large)-1]
+		large = large[:len(large)-1]
+
+		table.prob[s] = scaled[s]
+		table.alias[s] = l
+
+		scaled[l] = scaled[l] + scaled[s] - 1
+		if scaled[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+
+	// Remaining entries are full columns (leftovers from floating point rounding included)
+	for _, i := range large {
+		table.prob[i] = 1
+		table.alias[i] = i
+	}
+	for _, i := range small {
+		table.prob[i] = 1
+		table.alias[i] = i
+	}
+
+	return table
+}
+
+// pick returns a random index following the weights of the table
+func (t *aliasTable) pick() int {
+	i := rand.Intn(len(t.prob))
+	if rand.Float64() < t.prob[i] {
+		return i
+	}
+	return t.alias[i]
+}
@@ -0,0 +1,72 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for the alias table focusing on:
+// 1. Picks following the weights
+// 2. Zero weights never picked
+// 3. Degenerate inputs
+
+func TestAliasTable_FollowsWeights(t *testing.T) {
+	table := newAliasTable([]float64{70, 20, 10})
+
+	counts := make([]int, 3)
+	const samples = 100000
+	for i := 0; i < samples; i++ {
+		counts[table.pick()]++
+	}
+
+	assert.InDelta(t, 0.70, float64(counts[0])/samples, 0.02)
+	assert.InDelta(t, 0.20, float64(counts[1])/samples, 0.02)
+	assert.InDelta(t, 0.10, float64(counts[2])/samples, 0.02)
+}
+
+func TestAliasTable_ZeroWeightNeverPicked(t *testing.T) {
+	table := newAliasTable([]float64{0, 5, 0, 5})
+
+	for i := 0; i < 10000; i++ {
+		index := table.pick()
+		assert.True(t, index == 1 || index == 3, "Index %d has zero weight", index)
+	}
+}
+
+func TestAliasTable_AllZeroWeightsAreUniform(t *testing.T) {
+	table := newAliasTable([]float64{0, 0, 0})
+
+	seen := make(map[int]bool)
+	for i := 0; i < 1000; i++ {
+		seen[table.pick()] = true
+	}
+
+	assert.Len(t, seen, 3)
+}
+
+func TestAliasTable_SingleEntry(t *testing.T) {
+	table := newAliasTable([]float64{3})
+
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, 0, table.pick())
+	}
+}
+
+func TestNewWeightedNames_RemovesDuplicates(t *testing.T) {
+	names := newWeightedNames([]string{"Silva", "Gomes", "Silva"}, nameFrequencyData{
+		Default: 1,
+		Counts:  map[string]float64{"Silva": 9},
+	})
+
+	assert.Equal(t, []string{"Silva", "Gomes"}, names.names)
+
+	silva := 0
+	const samples = 20000
+	for i := 0; i < samples; i++ {
+		if names.pick() == "Silva" {
+			silva++
+		}
+	}
+	assert.InDelta(t, 0.9, float64(silva)/samples, 0.02)
+}
@@ -0,0 +1,193 @@
+package generators
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Limits of the street autocomplete
+const (
+	DefaultAutocompleteLimit = 10
+	MaxAutocompleteLimit     = 50
+	// MinAutocompleteQueryLength is the minimum number of letters and digits of a query
+	MinAutocompleteQueryLength = 2
+)
+
+// streetIndex is an inverted index of the tokens of the full street names (type and name)
+// Tokens are kept sorted so every token starting with a prefix is found by binary search
+type streetIndex struct {
+	entries  []streetIndexEntry
+	tokens   []string
+	postings map[string][]int
+}
+
+// streetIndexEntry is a real street stored in the index with its normalized full name
+type streetIndexEntry struct {
+	record  ZipcodeRecord
+	name    string
+	tokens  []string
+	cityKey string
+}
+
+// streetMatch is a street matching a query, with the values used to rank it
+type streetMatch struct {
+	entry       *streetIndexEntry
+	phrase      bool
+	exactTokens int
+}
+
+// newStreetIndex creates an empty street index
+func newStreetIndex() *streetIndex {
+	return &streetIndex{postings: make(map[string][]int)}
+}
+
+// add indexes a real street by the tokens of its full name; sortTokens must be called after the last street
+func (idx *streetIndex) add(record ZipcodeRecord) {
+	tokens := placeTokens(fullStreetName(record.RealAddress))
+	id := len(idx.entries)
+	idx.entries = append(idx.entries, streetIndexEntry{
+		record:  record,
+		name:    strings.Join(tokens, " "),
+		tokens:  tokens,
+		cityKey: cityKey(record.StateCode, record.City),
+	})
+
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+		if _, ok := idx.postings[token]; !ok {
+			idx.tokens = append(idx.tokens, token)
+		}
+		idx.postings[token] = append(idx.postings[token], id)
+	}
+}
+
+// sortTokens sorts the tokens for the prefix search
+func (idx *streetIndex) sortTokens() {
+	sort.Strings(idx.tokens)
+}
+
+// search returns the streets whose full name has a token starting with each token of the query, best ranked first
+// Streets whose name starts with the query come first, then the ones with more whole-word matches and shorter names
+// The state and the city key restrict the streets when not empty
+func (idx *streetIndex) search(query, stateCode, cityKeyFilter string, limit int) []ZipcodeRecord {
+	queryTokens := placeTokens(query)
+	if len(queryTokens) == 0 {
+		return nil
+	}
+
+	// Streets matching every token of the query
+	var candidates map[int]bool
+	for _, queryToken := range queryTokens {
+		matches := make(map[int]bool)
+		for _, token := range idx.tokensWithPrefix(queryToken) {
+			for _, id := range idx.postings[token] {
+				if candidates == nil || candidates[id] {
+					matches[id] = true
+				}
+			}
+		}
+		candidates = matches
+		if len(candidates) == 0 {
+			return nil
+		}
+	}
+
+	phrase := strings.Join(queryTokens, " ")
+	matches := make([]streetMatch, 0, len(candidates))
+	for id := range candidates {
+		entry := &idx.entries[id]
+		if stateCode != "" && entry.record.StateCode != stateCode {
+			continue
+		}
+		if cityKeyFilter != "" && entry.cityKey != cityKeyFilter {
+			continue
+		}
+		matches = append(matches, streetMatch{
+			entry:       entry,
+			phrase:      strings.HasPrefix(entry.name, phrase),
+			exactTokens: countExactTokens(entry.tokens, queryTokens),
+		})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.phrase != b.phrase {
+			return a.phrase
+		}
+		if a.exactTokens != b.exactTokens {
+			return a.exactTokens > b.exactTokens
+		}
+		if len(a.entry.name) != len(b.entry.name) {
+			return len(a.entry.name) < len(b.entry.name)
+		}
+		if a.entry.name != b.entry.name {
+			return a.entry.name < b.entry.name
+		}
+		return a.entry.record.CEP < b.entry.record.CEP
+	})
+
+	records := make([]ZipcodeRecord, 0, min(limit, len(matches)))
+	for _, match := range matches[:min(limit, len(matches))] {
+		records = append(records, match.entry.record)
+	}
+	return records
+}
+
+// tokensWithPrefix returns the indexed tokens starting with the prefix
+func (idx *streetIndex) tokensWithPrefix(prefix string) []string {
+	start := sort.SearchStrings(idx.tokens, prefix)
+	end := start
+	for end < len(idx.tokens) && strings.HasPrefix(idx.tokens[end], prefix) {
+		end++
+	}
+	return idx.tokens[start:end]
+}
+
+// countExactTokens counts the tokens of the query that are whole tokens of the street
+func countExactTokens(streetTokens, queryTokens []string) int {
+	count := 0
+	for _, queryToken := range queryTokens {
+		for _, token := range streetTokens {
+			if token == queryToken {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// placeTokens splits a normalized place name in words of letters and digits (ex: "Av. São João" -> av, sao, joao)
+func placeTokens(name string) []string {
+	return strings.FieldsFunc(normalizePlaceName(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// AutocompleteStreets suggests real streets whose full name matches the query, ignoring case and accents
+// Every word of the query must start a word of the street (ex: "r padre" matches Rua Padre André), optionally
+// within a state and a city; the limit falls back to the default when out of range
+func (g *Generator) AutocompleteStreets(query, stateCode, city string, limit int) *models.AutocompleteResponse {
+	if limit < 1 || limit > MaxAutocompleteLimit {
+		limit = DefaultAutocompleteLimit
+	}
+	stateCode = strings.ToUpper(stateCode)
+
+	records := g.dataStore.SearchStreets(query, stateCode, city, limit)
+	suggestions := make([]models.StreetResponse, 0, len(records))
+	for _, record := range records {
+		suggestions = append(suggestions, streetResponse(record.RealAddress, record.StateCode))
+	}
+
+	return &models.AutocompleteResponse{
+		Query:       query,
+		Suggestions: suggestions,
+	}
+}
@@ -0,0 +1,88 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for the street autocomplete focusing on:
+// 1. Accent and case insensitive prefix search over every word of the query
+// 2. Ranking of the suggestions
+// 3. State, city and limit restrictions
+
+func TestPlaceTokens(t *testing.T) {
+	assert.Equal(t, []string{"av", "sao", "joao", "1500"}, placeTokens("Av. São  João, 1500"))
+	assert.Empty(t, placeTokens(" - "))
+}
+
+func TestAutocompleteStreets_Prefix(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	response := gen.AutocompleteStreets("RUA pádre", "AC", "", 0)
+
+	assert.Equal(t, "RUA pádre", response.Query)
+	if assert.Len(t, response.Suggestions, 1) {
+		suggestion := response.Suggestions[0]
+		assert.Equal(t, "Rua Padre André", suggestion.Street)
+		assert.Equal(t, "69918-102", suggestion.Zipcode)
+		assert.Equal(t, "7º BEC", suggestion.Neighborhood)
+		assert.Equal(t, "Rio Branco", suggestion.City)
+		assert.Equal(t, "AC", suggestion.State)
+	}
+
+	// Every word must start a word of the street, in any order
+	response = gen.AutocompleteStreets("andre r", "AC", "", 0)
+	if assert.Len(t, response.Suggestions, 1) {
+		assert.Equal(t, "Rua Padre André", response.Suggestions[0].Street)
+	}
+
+	assert.Empty(t, gen.AutocompleteStreets("rua padre inexistente", "AC", "", 0).Suggestions)
+	assert.Empty(t, gen.AutocompleteStreets("...", "", "", 0).Suggestions)
+}
+
+func TestAutocompleteStreets_Ranking(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	response := gen.AutocompleteStreets("av ceara", "AC", "rio branco", 0)
+
+	if assert.Len(t, response.Suggestions, 3) {
+		// Shorter names first, homonyms ordered by CEP
+		assert.Equal(t, "69907-000", response.Suggestions[0].Zipcode)
+		assert.Equal(t, "69918-111", response.Suggestions[1].Zipcode)
+		assert.Equal(t, "Avenida Ceará, 3607", response.Suggestions[2].Street)
+	}
+}
+
+func TestStreetIndex_Ranking(t *testing.T) {
+	idx := newStreetIndex()
+	for _, name := range []string{"Travessa São Paulo", "São Paulo Apóstolo", "São Paulo", "Paulo Sãoleiro"} {
+		idx.add(ZipcodeRecord{RealAddress: RealAddress{Name: name, City: "Cidade"}, StateCode: "SP"})
+	}
+	idx.sortTokens()
+
+	var names []string
+	for _, record := range idx.search("sao paulo", "", "", 10) {
+		names = append(names, record.Name)
+	}
+
+	// Names starting with the query first, then whole-word matches, then shorter names
+	assert.Equal(t, []string{"São Paulo", "São Paulo Apóstolo", "Travessa São Paulo", "Paulo Sãoleiro"}, names)
+}
+
+func TestAutocompleteStreets_Filters(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	response := gen.AutocompleteStreets("rua", "", "", 5)
+	assert.Len(t, response.Suggestions, 5)
+
+	response = gen.AutocompleteStreets("rua", "sp", "Aguaí", MaxAutocompleteLimit)
+	assert.NotEmpty(t, response.Suggestions)
+	for _, suggestion := range response.Suggestions {
+		assert.Equal(t, "Aguaí", suggestion.City)
+		assert.Equal(t, "SP", suggestion.State)
+	}
+
+	response = gen.AutocompleteStreets("rua", "", "", MaxAutocompleteLimit+1)
+	assert.Len(t, response.Suggestions, DefaultAutocompleteLimit, "An out of range limit should fall back to the default")
+}
@@ -0,0 +1,419 @@
+package generators
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Civil registry certificate number (matrícula) parts
+const (
+	// certificateCollection is the collection code of the registry's own books
+	certificateCollection = "01"
+	// certificateIncorporatedCollection is the collection code of books incorporated from other registries
+	certificateIncorporatedCollection = "02"
+	// certificateService is the service code of the civil registry of natural persons
+	certificateService = "55"
+	// certificateLength is the length of the unmasked certificate number
+	certificateLength = 32
+	// certificateMinYear is the year the civil registry of natural persons started in Brazil
+	certificateMinYear = 1889
+)
+
+// Book types of the civil registry
+const (
+	certificateBookBirth             = 1 // Livro A
+	certificateBookMarriage          = 2 // Livro B
+	certificateBookReligiousMarriage = 3 // Livro B auxiliar
+	certificateBookDeath             = 4 // Livro C
+	certificateBookStillbirth        = 5 // Livro C auxiliar
+	certificateBookBanns             = 6 // Livro D
+	certificateBookOther             = 7 // Livro E
+)
+
+// certificateBookTypes contains the certificate type and the book letter of each book type
+var certificateBookTypes = map[int]struct{ certificateType, letter string }{
+	certificateBookBirth:             {"birth", "A"},
+	certificateBookMarriage:          {"marriage", "B"},
+	certificateBookReligiousMarriage: {"religious_marriage", "B Aux"},
+	certificateBookDeath:             {"death", "C"},
+	certificateBookStillbirth:        {"stillbirth", "C Aux"},
+	certificateBookBanns:             {"marriage_banns", "D"},
+	certificateBookOther:             {"other", "E"},
+}
+
+// Certificate types accepted by GenerateCertificate
+const (
+	CertificateTypeBirth    = "birth"
+	CertificateTypeMarriage = "marriage"
+)
+
+// CertificateOptions contains the parameters of a certificate generation
+type CertificateOptions struct {
+	// Type is the certificate type, birth when empty
+	Type string
+	// State is the state of the registry office, random when empty
+	State string
+	// Date is the birthdate (birth) or the marriage date (marriage) in YYYY-MM-DD format, random when empty
+	Date string
+	// Distribution selects how names are sampled, realistic when empty
+	Distribution NameDistribution
+}
+
+// Age of the spouses at the marriage
+const (
+	minMarriageAge = 18
+	maxMarriageAge = 45
+)
+
+// certificateRecord contains the registration data shared by every certificate type
+type certificateRecord struct {
+	number           string
+	unmasked         string
+	registryCNS      string
+	state            string
+	registrationDate string
+	book             string
+	page             string
+	term             string
+}
+
+// GenerateCertificate generates a birth or marriage certificate registered in a registry office of the state
+// The registration year of the number follows the birth or marriage date
+func (g *Generator) GenerateCertificate(opts CertificateOptions) *models.CertificateResponse {
+	ds := g.dataStore
+
+	state := ds.GetStateByCode(opts.State)
+	if state == nil {
+		state = ds.GetRandomState()
+	}
+	city := ds.GetRandomCityFromState(state)
+
+	if opts.Type == CertificateTypeMarriage {
+		return g.generateMarriageCertificate(state.Code, city, opts)
+	}
+	return g.generateBirthCertificate(state.Code, city, opts)
+}
+
+// generateBirthCertificate generates a birth certificate with the registered person and their filiation
+func (g *Generator) generateBirthCertificate(stateCode, city string, opts CertificateOptions) *models.CertificateResponse {
+	birthdate := opts.Date
+	if _, err := time.Parse("2006-01-02", birthdate); err != nil {
+		birthdate = generateBirthdate(MinPersonAge, DefaultMaxAge)
+	}
+
+	genders := []string{"male", "female"}
+	gender := genders[rand.Intn(len(genders))]
+	profile := g.newNameProfile(opts.Distribution, stateCode, birthdate)
+	name := g.generatePersonName(gender, profile)
+
+	record := g.generateCertificateRecord(stateCode, certificateBookBirth, birthRegistrationDate(birthdate))
+
+	return &models.CertificateResponse{
+		Type:             CertificateTypeBirth,
+		Number:           record.number,
+		Unmasked:         record.unmasked,
+		RegistryCNS:      record.registryCNS,
+		State:            record.state,
+		City:             city,
+		RegistrationDate: record.registrationDate,
+		Book:             record.book,
+		Page:             record.page,
+		Term:             record.term,
+		Registrant: &models.CertificateRegistrant{
+			Name:      name.FullName,
+			Gender:    gender,
+			Birthdate: birthdate,
+			BirthCity: city,
+			Filiation: g.generateFiliation(strings.Fields(name.LastName), profile),
+		},
+	}
+}
+
+// generateMarriageCertificate generates a marriage certificate with both spouses
+// Both spouses are adults at the marriage date, and half of the brides adopt the surname of the groom
+func (g *Generator) generateMarriageCertificate(stateCode, city string, opts CertificateOptions) *models.CertificateResponse {
+	marriage, err := time.Parse("2006-01-02", opts.Date)
+	if err != nil {
+		today := time.Now()
+		marriage = today.AddDate(0, 0, -rand.Intn(50*365))
+	}
+
+	groomBirth := marriage.AddDate(-(minMarriageAge + rand.Intn(maxMarriageAge-minMarriageAge+1)), 0, -rand.Intn(365))
+	brideBirth := marriage.AddDate(-(minMarriageAge + rand.Intn(maxMarriageAge-minMarriageAge+1)), 0, -rand.Intn(365))
+
+	groomProfile := g.newNameProfile(opts.Distribution, stateCode, groomBirth.Format("2006-01-02"))
+	brideProfile := g.newNameProfile(opts.Distribution, stateCode, brideBirth.Format("2006-01-02"))
+	groom := g.generatePersonName("male", groomProfile)
+	bride := g.generatePersonName("female", brideProfile)
+
+	brideAfterMarriage := bride
+	if rand.Intn(2) == 0 {
+		groomLastNames := strings.Fields(groom.LastName)
+		brideAfterMarriage = composePersonName(strings.Fields(bride.FirstName), append(strings.Fields(bride.LastName), groomLastNames[len(groomLastNames)-1]))
+	}
+
+	record := g.generateCertificateRecord(stateCode, certificateBookMarriage, marriage)
+
+	return &models.CertificateResponse{
+		Type:             CertificateTypeMarriage,
+		Number:           record.number,
+		Unmasked:         record.unmasked,
+		RegistryCNS:      record.registryCNS,
+		State:            record.state,
+		City:             city,
+		RegistrationDate: record.registrationDate,
+		Book:             record.book,
+		Page:             record.page,
+		Term:             record.term,
+		Spouses: []models.CertificateSpouse{
+			{
+				Name:              groom.FullName,
+				NameAfterMarriage: groom.FullName,
+				Gender:            "male",
+				Birthdate:         groomBirth.Format("2006-01-02"),
+				CPF:               g.generatePersonCPF().Masked,
+			},
+			{
+				Name:              bride.FullName,
+				NameAfterMarriage: brideAfterMarriage.FullName,
+				Gender:            "female",
+				Birthdate:         brideBirth.Format("2006-01-02"),
+				CPF:               g.generatePersonCPF().Masked,
+			},
+		},
+	}
+}
+
+// generatePersonBirthCertificate generates the birth certificate of a person born in the state at the given date
+func (g *Generator) generatePersonBirthCertificate(stateCode, birthdate string) *models.PersonBirthCertificate {
+	record := g.generateCertificateRecord(stateCode, certificateBookBirth, birthRegistrationDate(birthdate))
+
+	return &models.PersonBirthCertificate{
+		Number:           record.number,
+		Unmasked:         record.unmasked,
+		RegistryCNS:      record.registryCNS,
+		State:            record.state,
+		RegistrationDate: record.registrationDate,
+		Book:             record.book,
+		Page:             record.page,
+		Term:             record.term,
+	}
+}
+
+// generatePersonMarriageCertificate generates the marriage certificate of a couple married in the state at the given date
+func (g *Generator) generatePersonMarriageCertificate(stateCode string, marriage time.Time, spouse string) *models.PersonMarriageCertificate {
+	record := g.generateCertificateRecord(stateCode, certificateBookMarriage, marriage)
+
+	return &models.PersonMarriageCertificate{
+		Number:           record.number,
+		Unmasked:         record.unmasked,
+		RegistryCNS:      record.registryCNS,
+		State:            record.state,
+		RegistrationDate: record.registrationDate,
+		Book:             record.book,
+		Page:             record.page,
+		Term:             record.term,
+		Spouse:           spouse,
+	}
+}
+
+// birthRegistrationDate returns the registration date of a birth at the given date
+// Births are registered up to 60 days after the birth
+func birthRegistrationDate(birthdate string) time.Time {
+	birth, err := time.Parse("2006-01-02", birthdate)
+	if err != nil {
+		birth = time.Now()
+	}
+	return birth.AddDate(0, 0, rand.Intn(61))
+}
+
+// generateCertificateRecord generates the registration data of a certificate in a registry office of the state
+// Registration dates in the future are moved to today
+func (g *Generator) generateCertificateRecord(stateCode string, bookType int, registration time.Time) certificateRecord {
+	if today := time.Now(); registration.After(today) {
+		registration = today
+	}
+
+	state := g.dataStore.GetStateByCode(stateCode)
+	if state == nil {
+		state = g.dataStore.GetRandomState()
+	}
+
+	cns := generateRegistryCNS(state.IBGECode)
+	book := 1 + rand.Intn(400)
+	page := 1 + rand.Intn(300)
+	term := 1 + rand.Intn(120000)
+	unmasked := buildCertificateNumber(cns, registration.Year(), bookType, book, page, term)
+
+	return certificateRecord{
+		number:           FormatCertificateNumber(unmasked),
+		unmasked:         unmasked,
+		registryCNS:      cns,
+		state:            state.Code,
+		registrationDate: registration.Format("2006-01-02"),
+		book:             fmt.Sprintf("%s-%d", certificateBookTypes[bookType].letter, book),
+		page:             strconv.Itoa(page),
+		term:             strconv.Itoa(term),
+	}
+}
+
+// generateRegistryCNS generates the national registry code (CNS) of a registry office
+// The code starts with the IBGE code of the state, so offices of the same state share the prefix
+func generateRegistryCNS(stateIBGECode string) string {
+	if len(stateIBGECode) != 2 {
+		return fmt.Sprintf("%06d", 10000+rand.Intn(990000))
+	}
+	return fmt.Sprintf("%s%04d", stateIBGECode, rand.Intn(10000))
+}
+
+// buildCertificateNumber builds the 32-digit certificate number with its check digits
+func buildCertificateNumber(cns string, year, bookType, book, page, term int) string {
+	base := fmt.Sprintf("%s%s%s%04d%d%05d%03d%07d", cns, certificateCollection, certificateService, year, bookType, book, page, term)
+	return base + calculateCertificateCheckDigits(base)
+}
+
+// calculateCertificateCheckDigits calculates the 2 check digits of the 30 first digits of a certificate number
+// Each digit is computed with modulo 11 over weights cycling from 2 (first digit) and from 1 (second digit),
+// a remainder of 10 becomes 1
+func calculateCertificateCheckDigits(base string) string {
+	first := certificateCheckDigit(base, 2)
+	second := certificateCheckDigit(base+first, 1)
+	return first + second
+}
+
+// certificateCheckDigit calculates one check digit with weights starting at the given value
+func certificateCheckDigit(digits string, startWeight int) string {
+	sum := 0
+	for i, digit := range digits {
+		sum += int(digit-'0') * ((startWeight + i) % 11)
+	}
+
+	remainder := sum % 11
+	if remainder == 10 {
+		remainder = 1
+	}
+	return fmt.Sprintf("%d", remainder)
+}
+
+// FormatCertificateNumber formats a certificate number
+// (ex: 123456 01 55 2015 1 00012 123 0001234 56)
+func FormatCertificateNumber(number string) string {
+	if len(number) != certificateLength {
+		return number
+	}
+
+	parts := []string{
+		number[0:6],   // registry CNS
+		number[6:8],   // collection
+		number[8:10],  // service
+		number[10:14], // registration year
+		number[14:15], // book type
+		number[15:20], // book number
+		number[20:23], // page
+		number[23:30], // term
+		number[30:32], // check digits
+	}
+	return strings.Join(parts, " ")
+}
+
+// CleanCertificateNumber removes the formatting of a certificate number
+func CleanCertificateNumber(number string) string {
+	var clean strings.Builder
+	for _, r := range number {
+		if r != ' ' && r != '.' && r != '-' {
+			clean.WriteRune(r)
+		}
+	}
+	return clean.String()
+}
+
+// CertificateNumber contains the parts of a civil registry certificate number
+type CertificateNumber struct {
+	Unmasked    string
+	RegistryCNS string
+	Collection  string
+	Service     string
+	Year        int
+	BookType    int
+	// Type is the certificate type of the book (ex: birth, marriage, death)
+	Type string
+	Book int
+	Page int
+	Term int
+}
+
+// Certificate number validation errors
+var (
+	ErrCertificateLength      = errors.New("certificate number must have 32 digits")
+	ErrCertificateCollection  = errors.New("certificate number has an invalid collection code")
+	ErrCertificateService     = errors.New("certificate number is not from the civil registry of natural persons (service 55)")
+	ErrCertificateYear        = errors.New("certificate number has an invalid registration year")
+	ErrCertificateBookType    = errors.New("certificate number has an invalid book type")
+	ErrCertificateCheckDigits = errors.New("certificate number has invalid check digits")
+)
+
+// ParseCertificateNumber parses a certificate number, with or without formatting, and validates it
+// Returns the first validation error found
+func ParseCertificateNumber(number string) (*CertificateNumber, error) {
+	clean := CleanCertificateNumber(number)
+	if len(clean) != certificateLength {
+		return nil, ErrCertificateLength
+	}
+	for _, r := range clean {
+		if r < '0' || r > '9' {
+			return nil, ErrCertificateLength
+		}
+	}
+
+	collection := clean[6:8]
+	if collection != certificateCollection && collection != certificateIncorporatedCollection {
+		return nil, ErrCertificateCollection
+	}
+	if clean[8:10] != certificateService {
+		return nil, ErrCertificateService
+	}
+
+	year, _ := strconv.Atoi(clean[10:14])
+	if year < certificateMinYear || year > time.Now().Year() {
+		return nil, ErrCertificateYear
+	}
+
+	bookType, _ := strconv.Atoi(clean[14:15])
+	book, ok := certificateBookTypes[bookType]
+	if !ok {
+		return nil, ErrCertificateBookType
+	}
+
+	if calculateCertificateCheckDigits(clean[:30]) != clean[30:] {
+		return nil, ErrCertificateCheckDigits
+	}
+
+	bookNumber, _ := strconv.Atoi(clean[15:20])
+	page, _ := strconv.Atoi(clean[20:23])
+	term, _ := strconv.Atoi(clean[23:30])
+
+	return &CertificateNumber{
+		Unmasked:    clean,
+		RegistryCNS: clean[0:6],
+		Collection:  collection,
+		Service:     certificateService,
+		Year:        year,
+		BookType:    bookType,
+		Type:        book.certificateType,
+		Book:        bookNumber,
+		Page:        page,
+		Term:        term,
+	}, nil
+}
+
+// ValidateCertificateNumber checks if a certificate number is valid
+func ValidateCertificateNumber(number string) bool {
+	_, err := ParseCertificateNumber(number)
+	return err == nil
+}
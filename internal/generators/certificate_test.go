@@ -0,0 +1,159 @@
+package generators
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for civil registry certificate numbers focusing on:
+// 1. Check digits calculation
+// 2. Formatting
+// 3. Birth certificates consistent with the birthdate and registered in the birth state
+// 4. Marriage certificates with both spouses
+// 5. Parsing and validation of certificate numbers
+
+func TestBuildCertificateNumber_CheckDigits(t *testing.T) {
+	number := buildCertificateNumber("123456", 2015, certificateBookBirth, 12, 123, 1234)
+
+	assert.Equal(t, "12345601552015100012123000123413", number)
+	assert.Len(t, number, certificateLength)
+}
+
+func TestFormatCertificateNumber(t *testing.T) {
+	formatted := FormatCertificateNumber("12345601552015100012123000123413")
+
+	assert.Equal(t, "123456 01 55 2015 1 00012 123 0001234 13", formatted)
+	assert.Len(t, formatted, 40)
+	assert.Equal(t, "123", FormatCertificateNumber("123"), "Invalid lengths are returned unchanged")
+}
+
+func TestGeneratePersonBirthCertificate(t *testing.T) {
+	gen := newTestGenerator(t)
+	digits := regexp.MustCompile(`^\d{32}$`)
+
+	for i := 0; i < 50; i++ {
+		certificate := gen.generatePersonBirthCertificate("BA", "2016-05-20")
+
+		assert.Regexp(t, digits, certificate.Unmasked)
+		assert.Equal(t, certificate.RegistryCNS, certificate.Unmasked[:6])
+		assert.Equal(t, "2016", certificate.Unmasked[10:14], "Registration year should follow the birth")
+		assert.Equal(t, "1", certificate.Unmasked[14:15], "Birth certificates are registered in book A")
+		assert.Equal(t, calculateCertificateCheckDigits(certificate.Unmasked[:30]), certificate.Unmasked[30:])
+		assert.GreaterOrEqual(t, certificate.RegistrationDate, "2016-05-20")
+		assert.LessOrEqual(t, certificate.RegistrationDate, "2016-07-19")
+		assert.Equal(t, "BA", certificate.State)
+		assert.True(t, strings.HasPrefix(certificate.RegistryCNS, "29"), "CNS should start with the IBGE code of BA")
+		assert.True(t, ValidateCertificateNumber(certificate.Number))
+	}
+}
+
+func TestGenerateCertificate_Birth(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 30; i++ {
+		certificate := gen.GenerateCertificate(CertificateOptions{State: "rj", Date: "1990-12-20"})
+
+		assert.Equal(t, CertificateTypeBirth, certificate.Type)
+		assert.Equal(t, "RJ", certificate.State)
+		assert.True(t, strings.HasPrefix(certificate.RegistryCNS, "33"), "CNS should start with the IBGE code of RJ")
+		assert.Contains(t, gen.GetDataStore().GetStateByCode("RJ").Cities, certificate.City)
+		assert.True(t, strings.HasPrefix(certificate.Book, "A-"))
+		assert.Contains(t, []string{"1990", "1991"}, certificate.Unmasked[10:14], "Registration year should follow the birthdate")
+		assert.True(t, ValidateCertificateNumber(certificate.Unmasked))
+
+		if assert.NotNil(t, certificate.Registrant) {
+			assert.Equal(t, "1990-12-20", certificate.Registrant.Birthdate)
+			assert.Equal(t, certificate.City, certificate.Registrant.BirthCity)
+			lastNames := strings.Fields(certificate.Registrant.Name)
+			assert.True(t, strings.HasSuffix(certificate.Registrant.Filiation.Father, lastNames[len(lastNames)-1]), "Father should pass the last surname")
+		}
+		assert.Empty(t, certificate.Spouses)
+	}
+}
+
+func TestGenerateCertificate_RandomState(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	certificate := gen.GenerateCertificate(CertificateOptions{})
+	state := gen.GetDataStore().GetStateByCode(certificate.State)
+
+	if assert.NotNil(t, state) {
+		assert.Equal(t, state.IBGECode, certificate.RegistryCNS[:2])
+	}
+	assert.Equal(t, CertificateTypeBirth, certificate.Type)
+	assert.LessOrEqual(t, certificate.RegistrationDate, time.Now().Format("2006-01-02"))
+}
+
+func TestGenerateCertificate_Marriage(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 30; i++ {
+		certificate := gen.GenerateCertificate(CertificateOptions{Type: CertificateTypeMarriage, State: "SP", Date: "2010-06-12"})
+
+		assert.Equal(t, CertificateTypeMarriage, certificate.Type)
+		assert.Equal(t, "2010-06-12", certificate.RegistrationDate)
+		assert.Equal(t, "2010", certificate.Unmasked[10:14])
+		assert.Equal(t, "2", certificate.Unmasked[14:15], "Marriage certificates are registered in book B")
+		assert.True(t, strings.HasPrefix(certificate.Book, "B-"))
+		assert.Nil(t, certificate.Registrant)
+
+		if assert.Len(t, certificate.Spouses, 2) {
+			groom, bride := certificate.Spouses[0], certificate.Spouses[1]
+			assert.Equal(t, "male", groom.Gender)
+			assert.Equal(t, "female", bride.Gender)
+			assert.Equal(t, groom.Name, groom.NameAfterMarriage)
+			assert.True(t, strings.HasPrefix(bride.NameAfterMarriage, bride.Name), "Bride keeps her names and may add the surname of the groom")
+			for _, spouse := range certificate.Spouses {
+				assert.LessOrEqual(t, spouse.Birthdate, "1992-06-12", "Spouses should be adults at the marriage")
+				assert.True(t, ValidateCPF(spouse.CPF))
+			}
+		}
+	}
+}
+
+func TestParseCertificateNumber(t *testing.T) {
+	number, err := ParseCertificateNumber("123456 01 55 2015 1 00012 123 0001234 13")
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, number) {
+		assert.Equal(t, "12345601552015100012123000123413", number.Unmasked)
+		assert.Equal(t, "123456", number.RegistryCNS)
+		assert.Equal(t, 2015, number.Year)
+		assert.Equal(t, "birth", number.Type)
+		assert.Equal(t, 12, number.Book)
+		assert.Equal(t, 123, number.Page)
+		assert.Equal(t, 1234, number.Term)
+	}
+}
+
+func TestParseCertificateNumber_Invalid(t *testing.T) {
+	valid := "12345601552015100012123000123413"
+	nextYear := buildCertificateNumber("123456", time.Now().Year()+1, certificateBookBirth, 12, 123, 1234)
+	bookType8 := valid[:14] + "8" + valid[15:30]
+
+	tests := []struct {
+		name   string
+		number string
+		err    error
+	}{
+		{"too short", valid[:31], ErrCertificateLength},
+		{"letters", "1234560155201510001212300012341A", ErrCertificateLength},
+		{"collection", valid[:6] + "03" + valid[8:], ErrCertificateCollection},
+		{"service", valid[:8] + "54" + valid[10:], ErrCertificateService},
+		{"future year", nextYear, ErrCertificateYear},
+		{"book type", bookType8 + calculateCertificateCheckDigits(bookType8), ErrCertificateBookType},
+		{"check digits", valid[:30] + "00", ErrCertificateCheckDigits},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCertificateNumber(tt.number)
+			assert.ErrorIs(t, err, tt.err)
+			assert.False(t, ValidateCertificateNumber(tt.number))
+		})
+	}
+}
@@ -21,16 +21,29 @@ func (g *Generator) GenerateCNPJ(formatted bool, valid bool) string {
 		return cnpjStr
 	}
 
-	// Generate 12 random digits
-	cnpj := make([]int, 12)
-	for i := 0; i < 8; i++ {
-		cnpj[i] = rand.Intn(10)
+	// Headquarters (matriz) of a new root
+	cnpjStr := BuildCNPJ(generateCNPJRoot(), 1)
+
+	if formatted {
+		return FormatCNPJ(cnpjStr)
+	}
+	return cnpjStr
+}
+
+// generateCNPJRoot generates the 8-digit root of a CNPJ, shared by the headquarters and the branches
+func generateCNPJRoot() string {
+	return fmt.Sprintf("%08d", rand.Intn(100000000))
+}
+
+// BuildCNPJ builds the unformatted CNPJ of a branch of the root with its check digits
+// Branch 1 (0001) is the headquarters (matriz) and the next ones are the branches (filiais)
+func BuildCNPJ(root string, branch int) string {
+	base := fmt.Sprintf("%s%04d", root, branch)
+
+	cnpj := make([]int, 0, 14)
+	for _, c := range base {
+		cnpj = append(cnpj, int(c-'0'))
 	}
-	// Define the matrix as 0001
-	cnpj[8] = 0
-	cnpj[9] = 0
-	cnpj[10] = 0
-	cnpj[11] = 1
 
 	// Calculate first check digit
 	firstCheck := calculateCNPJCheckDigit(cnpj, []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})
@@ -38,17 +51,8 @@ func (g *Generator) GenerateCNPJ(formatted bool, valid bool) string {
 
 	// Calculate second check digit
 	secondCheck := calculateCNPJCheckDigit(cnpj, []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})
-	cnpj = append(cnpj, secondCheck)
-
-	cnpjStr := ""
-	for _, digit := range cnpj {
-		cnpjStr += strconv.Itoa(digit)
-	}
 
-	if formatted {
-		return FormatCNPJ(cnpjStr)
-	}
-	return cnpjStr
+	return fmt.Sprintf("%s%d%d", base, firstCheck, secondCheck)
 }
 
 // calculateCNPJCheckDigit calculates the check digit of the CNPJ
@@ -118,3 +122,31 @@ func ValidateCNPJ(cnpj string) bool {
 
 	return true
 }
+
+// CNPJNumber contains the parts of a valid CNPJ
+type CNPJNumber struct {
+	Unmasked string
+	// Root identifies the company and is shared by all its establishments
+	Root string
+	// Branch is the number of the establishment, 1 for the headquarters
+	Branch       int
+	Headquarters bool
+}
+
+// ParseCNPJ validates a CNPJ and splits it in root and branch number
+// It returns nil when the CNPJ is invalid
+func ParseCNPJ(cnpj string) *CNPJNumber {
+	if !ValidateCNPJ(cnpj) {
+		return nil
+	}
+
+	clean := strings.NewReplacer(".", "", "/", "", "-", "").Replace(cnpj)
+	branch, _ := strconv.Atoi(clean[8:12])
+
+	return &CNPJNumber{
+		Unmasked:     clean,
+		Root:         clean[:8],
+		Branch:       branch,
+		Headquarters: branch == 1,
+	}
+}
@@ -12,6 +12,7 @@ import (
 // 2. Validation against reserved cases (data security)
 // 3. Robust formatting (UX)
 // 4. Edge case coverage (maintainability)
+// 5. Branches sharing the root and parsing of root and branch number
 
 func TestGenerateCNPJ_Valid_Formatted(t *testing.T) {
 	gen := NewGenerator(nil)
@@ -207,3 +208,31 @@ func TestValidateCNPJ_Invalid(t *testing.T) {
 	}
 }
 
+func TestBuildCNPJ_Branches(t *testing.T) {
+	root := generateCNPJRoot()
+
+	for branch := 1; branch <= 20; branch++ {
+		cnpj := BuildCNPJ(root, branch)
+
+		assert.Len(t, cnpj, 14)
+		assert.True(t, ValidateCNPJ(cnpj), "CNPJ of branch %d should be valid: %s", branch, cnpj)
+		assert.Equal(t, root, cnpj[:8], "Branches should share the root")
+	}
+}
+
+func TestParseCNPJ(t *testing.T) {
+	parsed := ParseCNPJ("11.222.333/0001-81")
+	if assert.NotNil(t, parsed) {
+		assert.Equal(t, "11222333", parsed.Root)
+		assert.Equal(t, 1, parsed.Branch)
+		assert.True(t, parsed.Headquarters)
+	}
+
+	parsed = ParseCNPJ(FormatCNPJ(BuildCNPJ("11222333", 27)))
+	if assert.NotNil(t, parsed) {
+		assert.Equal(t, 27, parsed.Branch)
+		assert.False(t, parsed.Headquarters)
+	}
+
+	assert.Nil(t, ParseCNPJ("11.222.333/0001-82"))
+}
@@ -2,6 +2,7 @@ package generators
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"time"
@@ -9,38 +10,376 @@ import (
 	"github.com/diogomcd/fake-mill-api/internal/models"
 )
 
+// Company sizes (porte) by annual revenue
+const (
+	// CompanySizeME is a microempresa, with revenue up to R$ 360 thousand
+	CompanySizeME = "ME"
+	// CompanySizeEPP is an empresa de pequeno porte, with revenue up to R$ 4.8 million
+	CompanySizeEPP = "EPP"
+	// CompanySizeOther covers the other companies, including every S.A.
+	CompanySizeOther = "DEMAIS"
+)
+
+// Registration statuses (situação cadastral) of companies at the Receita Federal
+const (
+	CompanyStatusActive    = "ATIVA"
+	CompanyStatusSuspended = "SUSPENSA"
+	CompanyStatusUnfit     = "INAPTA"
+	CompanyStatusClosed    = "BAIXADA"
+)
+
+// Age of generated companies in years
+const (
+	minCompanyAge = 1
+	maxCompanyAge = 21
+)
+
+// Share of the micro and small companies that opted for the Simples Nacional
+const simplesNacionalShare = 75
+
+// companyNouns are the words following the surname in company names
+var companyNouns = []string{"Solutions", "Systems", "Tech", "Digital", "Consulting", "Group", "Enterprises", "Mill", "Empresa", "Comércio", "Soluções", "Serviços"}
+
+// companyStatuses contains the registration statuses and their weights
+var companyStatuses = []struct {
+	status string
+	weight int
+}{
+	{CompanyStatusActive, 85},
+	{CompanyStatusClosed, 6},
+	{CompanyStatusUnfit, 6},
+	{CompanyStatusSuspended, 3},
+}
+
+// shareCapitalRanges contains the share capital range in BRL by company size
+// Companies whose capital is not divided in quotas (S.A.) use corporationCapitalRange
+var shareCapitalRanges = map[string][2]float64{
+	CompanySizeME:    {1000, 100000},
+	CompanySizeEPP:   {50000, 1000000},
+	CompanySizeOther: {500000, 20000000},
+}
+
+// corporationCapitalRange is the share capital range in BRL of corporations (S.A.)
+var corporationCapitalRange = [2]float64{1000000, 500000000}
+
+// CompanyOptions contains the constraints used to generate a company
+// Empty fields are chosen at random
+type CompanyOptions struct {
+	// State is the state code of the address and phone of the company
+	State string
+	// Region restricts the address to a region (ex: Nordeste)
+	Region string
+	// Capital restricts the address to state capitals (true) or to the other cities (false)
+	Capital *bool
+	// Activity is a CNAE code or prefix (ex: 62 or 6201-5/01) of the main activity
+	Activity string
+	// Size is the size (porte) of the company: ME, EPP or DEMAIS
+	Size string
+}
+
+// companyEmailUsers are the usernames of the contact emails of companies
+var companyEmailUsers = []string{"contato", "comercial", "atendimento", "financeiro", "vendas", "sac"}
+
+// IsValidCompanySize reports whether the value is a supported company size
+func IsValidCompanySize(size string) bool {
+	return size == CompanySizeME || size == CompanySizeEPP || size == CompanySizeOther
+}
+
 // GenerateCompany generates complete fake company data
 func (g *Generator) GenerateCompany() *models.CompanyResponse {
+	return g.GenerateCompanyWithOptions(CompanyOptions{})
+}
+
+// GenerateCompanyWithOptions generates complete fake company data following the constraints
+// The phone DDD follows the city of the address, and the contact email uses a domain derived from the trade name
+func (g *Generator) GenerateCompanyWithOptions(opts CompanyOptions) *models.CompanyResponse {
 	ds := g.dataStore
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	// Foundation date (1 to 21 years ago), which defines the legal natures available
+	foundedAt := generateFoundationDate(today)
+	nature := g.pickLegalNature(foundedAt, opts.Size)
 
-	// Company name
-	companyNouns := []string{"Solutions", "Systems", "Tech", "Digital", "Consulting", "Group", "Enterprises", "Mill", "Cooperativa", "Empresa", "Comércio", "Soluções"}
-	companySuffixes := []string{"LTDA", "S.A.", "ME", "EIRELI"}
-	companyName := fmt.Sprintf("%s %s %s", ds.GetRandomLastName(), companyNouns[rand.Intn(len(companyNouns))], companySuffixes[rand.Intn(len(companySuffixes))])
-	tradeName := strings.Split(companyName, " ")[0] + " " + companyNouns[rand.Intn(len(companyNouns))]
+	// Company name, ending with the suffix of the legal nature
+	lastName := ds.GetRandomLastName()
+	companyName := fmt.Sprintf("%s %s %s", lastName, companyNouns[rand.Intn(len(companyNouns))], nature.Suffix)
+	tradeName := lastName + " " + companyNouns[rand.Intn(len(companyNouns))]
 
 	// CNPJ number
 	cnpj := g.GenerateCNPJ(true, true)
 
 	// State registration (simplified) - maximum 14 characters
-	stateRegistration := fmt.Sprintf("%02d.%03d.%03d.%03d", rand.Intn(100), rand.Intn(1000), rand.Intn(1000), rand.Intn(1000))
+	stateRegistration := generateStateRegistration()
 
-	// Email, phone, address
-	email, _, _ := g.GenerateEmail("")
-	phone, _, _, _ := g.GeneratePhone("", "landline")
-	address := g.GenerateAddress("", "")
+	// Address, landline in the area of the city and contact email on the corporate domain
+	address := g.GenerateAddress(g.PickLocation(LocationOptions{
+		State:   ds.ValidateAndSanitizeState(opts.State),
+		Region:  opts.Region,
+		Capital: opts.Capital,
+	}))
+	phone := fmt.Sprintf("(%s) %s", ds.GetDDDForCity(address.State, address.City), generatePhoneNumber("landline"))
+	domain := generateCompanyDomain(tradeName)
+	email := fmt.Sprintf("%s@%s", companyEmailUsers[rand.Intn(len(companyEmailUsers))], domain)
 
-	// Foundation date (1 to 20 years ago)
-	foundedAt := time.Now().AddDate(-(1 + rand.Intn(20)), rand.Intn(12)+1, rand.Intn(28)+1).Format("2006-01-02")
+	size := opts.Size
+	if size == "" {
+		size = generateCompanySize(nature)
+	}
+	shareCapital := generateShareCapital(size, nature)
+	status, statusDate := generateCompanyStatus(foundedAt, today)
+	mainActivity := g.pickMainActivity(opts.Activity)
 
 	return &models.CompanyResponse{
-		Name:              companyName,
-		TradeName:         tradeName,
-		CNPJ:              cnpj,
-		StateRegistration: stateRegistration,
-		Email:             email,
-		Phone:             phone,
-		FoundedAt:         foundedAt,
-		Address:           *address,
+		Name:                companyName,
+		TradeName:           tradeName,
+		CNPJ:                cnpj,
+		StateRegistration:   stateRegistration,
+		Email:               email,
+		Domain:              domain,
+		Phone:               phone,
+		FoundedAt:           foundedAt.Format("2006-01-02"),
+		Address:             *address,
+		LegalNature:         models.CompanyLegalNature{Code: nature.Code, Description: nature.Description},
+		Size:                size,
+		ShareCapital:        FormatBRL(shareCapital),
+		ShareCapitalValue:   shareCapital,
+		SimplesNacional:     size != CompanySizeOther && rand.Intn(100) < simplesNacionalShare,
+		Status:              status,
+		StatusDate:          statusDate.Format("2006-01-02"),
+		MainActivity:        companyActivity(mainActivity),
+		SecondaryActivities: g.generateSecondaryActivities(mainActivity),
+		Partners:            g.generateCompanyPartners(nature, foundedAt, today, address.State),
+	}
+}
+
+// generateStateRegistration generates a simplified state registration (inscrição estadual)
+func generateStateRegistration() string {
+	return fmt.Sprintf("%02d.%03d.%03d.%03d", rand.Intn(100), rand.Intn(1000), rand.Intn(1000), rand.Intn(1000))
+}
+
+// pickMainActivity picks a random activity matching the CNAE code or prefix, or any activity when none matches
+func (g *Generator) pickMainActivity(code string) ActivityData {
+	if activities := g.dataStore.FindActivities(code); len(activities) > 0 {
+		return activities[rand.Intn(len(activities))]
+	}
+	return g.dataStore.GetRandomActivity()
+}
+
+// generateCompanyDomain derives the corporate domain of a company from its trade name (ex: silvatech.com.br)
+func generateCompanyDomain(tradeName string) string {
+	var name strings.Builder
+	for _, r := range strings.ToLower(removeAccents(tradeName)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			name.WriteRune(r)
+		}
+	}
+
+	extension := "com.br"
+	if rand.Intn(100) < 20 {
+		extension = "com"
+	}
+	return name.String() + "." + extension
+}
+
+// onlyDigits removes every character of the value that is not a digit
+func onlyDigits(value string) string {
+	var digits strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	return digits.String()
+}
+
+// generateFoundationDate generates a foundation date between 1 and 21 years before today
+func generateFoundationDate(today time.Time) time.Time {
+	earliest := today.AddDate(-maxCompanyAge, 0, 0)
+	latest := today.AddDate(-minCompanyAge, 0, 0)
+	return earliest.AddDate(0, 0, rand.Intn(int(latest.Sub(earliest).Hours()/24)+1))
+}
+
+// pickLegalNature picks a legal nature available at the foundation date following the weights
+// EIRELI only existed between 2012 and 2021, and the SLU since 2019; ME and EPP exclude corporations
+func (g *Generator) pickLegalNature(foundedAt time.Time, size string) LegalNatureData {
+	date := foundedAt.Format("2006-01-02")
+	small := size == CompanySizeME || size == CompanySizeEPP
+
+	candidates := make([]LegalNatureData, 0)
+	total := 0.0
+	for _, nature := range g.dataStore.GetLegalNatures() {
+		if (nature.Since != "" && date < nature.Since) || (nature.Until != "" && date > nature.Until) {
+			continue
+		}
+		if small && !nature.Quotas {
+			continue
+		}
+		candidates = append(candidates, nature)
+		total += nature.Weight
+	}
+
+	target := rand.Float64() * total
+	for _, nature := range candidates {
+		target -= nature.Weight
+		if target < 0 {
+			return nature
+		}
+	}
+	return candidates[len(candidates)-1]
+}
+
+// generateCompanySize picks the size of a company
+// Companies not divided in quotas (S.A.) cannot be ME or EPP (Lei Complementar 123/2006)
+func generateCompanySize(nature LegalNatureData) string {
+	if !nature.Quotas {
+		return CompanySizeOther
+	}
+	switch roll := rand.Intn(100); {
+	case roll < 65:
+		return CompanySizeME
+	case roll < 85:
+		return CompanySizeEPP
+	default:
+		return CompanySizeOther
+	}
+}
+
+// generateShareCapital generates the share capital of a company, rounded to thousands
+// The value is log-uniform in the range of the size, so small values are as common as large ones
+func generateShareCapital(size string, nature LegalNatureData) float64 {
+	capitalRange := shareCapitalRanges[size]
+	if !nature.Quotas {
+		capitalRange = corporationCapitalRange
+	}
+	logMin, logMax := math.Log(capitalRange[0]), math.Log(capitalRange[1])
+	value := math.Exp(logMin + rand.Float64()*(logMax-logMin))
+	return math.Max(capitalRange[0], math.Round(value/1000)*1000)
+}
+
+// generateCompanyStatus picks the registration status of a company and the date it was set
+// Active companies keep the status since the foundation
+func generateCompanyStatus(foundedAt, today time.Time) (string, time.Time) {
+	total := 0
+	for _, s := range companyStatuses {
+		total += s.weight
+	}
+
+	status := CompanyStatusActive
+	roll := rand.Intn(total)
+	for _, s := range companyStatuses {
+		if roll < s.weight {
+			status = s.status
+			break
+		}
+		roll -= s.weight
+	}
+
+	if status == CompanyStatusActive {
+		return status, foundedAt
+	}
+	days := int(today.Sub(foundedAt).Hours() / 24)
+	return status, foundedAt.AddDate(0, 0, 1+rand.Intn(max(days, 1)))
+}
+
+// companyActivity converts an activity of the data store to the model
+func companyActivity(activity ActivityData) models.CompanyActivity {
+	return models.CompanyActivity{Code: activity.Code, Description: activity.Description}
+}
+
+// generateSecondaryActivities picks up to 4 secondary activities, preferably of the same CNAE division
+func (g *Generator) generateSecondaryActivities(main ActivityData) []models.CompanyActivity {
+	count := 0
+	if rand.Intn(100) >= 40 {
+		count = 1 + rand.Intn(4)
+	}
+
+	division := main.Code[:2]
+	related := make([]ActivityData, 0)
+	others := make([]ActivityData, 0)
+	for _, activity := range g.dataStore.GetActivities() {
+		switch {
+		case activity.Code == main.Code:
+		case activity.Code[:2] == division:
+			related = append(related, activity)
+		default:
+			others = append(others, activity)
+		}
+	}
+	rand.Shuffle(len(related), func(i, j int) { related[i], related[j] = related[j], related[i] })
+	rand.Shuffle(len(others), func(i, j int) { others[i], others[j] = others[j], others[i] })
+
+	activities := make([]models.CompanyActivity, 0, count)
+	for _, activity := range append(related, others...) {
+		if len(activities) == count {
+			break
+		}
+		activities = append(activities, companyActivity(activity))
+	}
+	return activities
+}
+
+// generateCompanyPartners generates the QSA (quadro de sócios e administradores) of a company
+// The first partner is the administrator; later partners may have joined after the foundation,
+// and every partner was an adult when they joined
+func (g *Generator) generateCompanyPartners(nature LegalNatureData, foundedAt, today time.Time, stateCode string) []models.CompanyPartner {
+	count := nature.MinPartners + rand.Intn(nature.MaxPartners-nature.MinPartners+1)
+
+	var shares []float64
+	if nature.Quotas {
+		shares = splitShares(count)
+	}
+
+	partners := make([]models.CompanyPartner, 0, count)
+	for i := 0; i < count; i++ {
+		qualification := nature.Administrator
+		if i > 0 && nature.Member != "" && rand.Intn(100) >= 20 {
+			qualification = nature.Member
+		}
+
+		entry := foundedAt
+		if i > 0 && rand.Intn(100) < 30 {
+			entry = foundedAt.AddDate(0, 0, rand.Intn(max(int(today.Sub(foundedAt).Hours()/24), 1)))
+		}
+
+		// Adult at the entry date
+		minAge := AdultAge + today.Year() - entry.Year() + 1
+		birthdate := generateBirthdate(minAge, max(minAge, 80))
+		gender := []string{GenderMale, GenderFemale}[rand.Intn(2)]
+		name := g.generatePersonName(gender, g.newNameProfile("", stateCode, birthdate))
+
+		partner := models.CompanyPartner{
+			Name:              name.FullName,
+			CPF:               FormatCPF(g.GenerateCPF(false, true)),
+			QualificationCode: qualification,
+			Qualification:     g.dataStore.GetQualification(qualification),
+			EntryDate:         entry.Format("2006-01-02"),
+		}
+		if shares != nil {
+			partner.Share = shares[i]
+		}
+		partners = append(partners, partner)
+	}
+
+	return partners
+}
+
+// splitShares splits 100% of the quotas among the partners in whole percentages
+// The rounding remainder goes to the first partner
+func splitShares(count int) []float64 {
+	weights := make([]int, count)
+	total := 0
+	for i := range weights {
+		weights[i] = 1 + rand.Intn(9)
+		total += weights[i]
+	}
+
+	shares := make([]float64, count)
+	assigned := 0
+	for i, weight := range weights {
+		share := weight * 100 / total
+		shares[i] = float64(share)
+		assigned += share
 	}
+	shares[0] += float64(100 - assigned)
+	return shares
 }
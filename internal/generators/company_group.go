@@ -0,0 +1,82 @@
+package generators
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Number of branches of generated company groups
+const (
+	MinCompanyBranches        = 1
+	MaxCompanyBranches        = 50
+	defaultMaxCompanyBranches = 5
+)
+
+// Share of the branches located in the state of the headquarters
+const sameStateBranchShare = 50
+
+// GenerateCompanyGroup generates a headquarters (matriz) and its branches (filiais)
+// The branches share the CNPJ root with sequential branch numbers (0002, 0003...), and each one has
+// its own address, possibly in another state, and its own state registration
+// A random number of branches (1 to 5) is used when branches is out of range
+func (g *Generator) GenerateCompanyGroup(opts CompanyOptions, branches int) *models.CompanyGroupResponse {
+	if branches < MinCompanyBranches || branches > MaxCompanyBranches {
+		branches = MinCompanyBranches + rand.Intn(defaultMaxCompanyBranches)
+	}
+
+	headquarters := g.GenerateCompanyWithOptions(opts)
+	root := ParseCNPJ(headquarters.CNPJ).Root
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	foundedAt, _ := time.Parse("2006-01-02", headquarters.FoundedAt)
+
+	group := &models.CompanyGroupResponse{
+		Root:         root,
+		Headquarters: *headquarters,
+		Branches:     make([]models.CompanyBranch, 0, branches),
+	}
+	for i := 0; i < branches; i++ {
+		group.Branches = append(group.Branches, g.generateCompanyBranch(headquarters, root, i+2, foundedAt, today))
+	}
+
+	return group
+}
+
+// generateCompanyBranch generates a branch of the headquarters, opened after its foundation
+// Branches of inactive headquarters share their status and its date
+func (g *Generator) generateCompanyBranch(headquarters *models.CompanyResponse, root string, number int, foundedAt, today time.Time) models.CompanyBranch {
+	ds := g.dataStore
+
+	stateCode := headquarters.Address.State
+	if rand.Intn(100) >= sameStateBranchShare {
+		stateCode = ds.GetRandomState().Code
+	}
+	address := g.GenerateAddress(stateCode, "")
+
+	// Branches open before the headquarters leaves the active status
+	latest := today.AddDate(0, 0, -30)
+	hqStatusDate, _ := time.Parse("2006-01-02", headquarters.StatusDate)
+	if headquarters.Status != CompanyStatusActive {
+		latest = hqStatusDate
+	}
+	openedAt := foundedAt.AddDate(0, 0, rand.Intn(max(int(latest.Sub(foundedAt).Hours()/24), 1)))
+
+	status, statusDate := generateCompanyStatus(openedAt, today)
+	if headquarters.Status != CompanyStatusActive {
+		status, statusDate = headquarters.Status, hqStatusDate
+	}
+
+	return models.CompanyBranch{
+		CNPJ:              FormatCNPJ(BuildCNPJ(root, number)),
+		Branch:            fmt.Sprintf("%04d", number),
+		StateRegistration: generateStateRegistration(),
+		Phone:             fmt.Sprintf("(%s) %s", ds.GetDDDForCity(address.State, address.City), generatePhoneNumber("landline")),
+		FoundedAt:         openedAt.Format("2006-01-02"),
+		Status:            status,
+		StatusDate:        statusDate.Format("2006-01-02"),
+		Address:           *address,
+	}
+}
@@ -0,0 +1,69 @@
+package generators
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for company groups focusing on:
+// 1. Branches sharing the CNPJ root with sequential branch numbers
+// 2. Own address, state registration and phone of each branch
+// 3. Opening and status dates consistent with the headquarters
+
+func TestGenerateCompanyGroup_Branches(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	group := gen.GenerateCompanyGroup(CompanyOptions{State: "SC"}, 8)
+
+	assert.Equal(t, "SC", group.Headquarters.Address.State)
+	headquarters := ParseCNPJ(group.Headquarters.CNPJ)
+	if assert.NotNil(t, headquarters) {
+		assert.True(t, headquarters.Headquarters)
+		assert.Equal(t, group.Root, headquarters.Root)
+	}
+
+	assert.Len(t, group.Branches, 8)
+	registrations := map[string]bool{group.Headquarters.StateRegistration: true}
+	for i, branch := range group.Branches {
+		parsed := ParseCNPJ(branch.CNPJ)
+		if assert.NotNil(t, parsed, branch.CNPJ) {
+			assert.Equal(t, group.Root, parsed.Root)
+			assert.Equal(t, i+2, parsed.Branch)
+			assert.False(t, parsed.Headquarters)
+		}
+		assert.Equal(t, fmt.Sprintf("%04d", i+2), branch.Branch)
+		assert.Equal(t, gen.dataStore.GetDDDForCity(branch.Address.State, branch.Address.City), branch.Phone[1:3])
+		registrations[branch.StateRegistration] = true
+	}
+	assert.Len(t, registrations, 9, "Each establishment should have its own state registration")
+}
+
+func TestGenerateCompanyGroup_RandomBranches(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for _, branches := range []int{0, -1, MaxCompanyBranches + 1} {
+		group := gen.GenerateCompanyGroup(CompanyOptions{}, branches)
+		assert.GreaterOrEqual(t, len(group.Branches), MinCompanyBranches)
+		assert.LessOrEqual(t, len(group.Branches), 5)
+	}
+}
+
+func TestGenerateCompanyGroup_Dates(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		group := gen.GenerateCompanyGroup(CompanyOptions{}, 3)
+		headquarters := group.Headquarters
+
+		for _, branch := range group.Branches {
+			assert.GreaterOrEqual(t, branch.FoundedAt, headquarters.FoundedAt, "Branches open after the headquarters")
+			assert.GreaterOrEqual(t, branch.StatusDate, branch.FoundedAt)
+			if headquarters.Status != CompanyStatusActive {
+				assert.Equal(t, headquarters.Status, branch.Status)
+				assert.LessOrEqual(t, branch.FoundedAt, headquarters.StatusDate)
+			}
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package generators
 
 import (
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -10,6 +13,10 @@ import (
 // 1. Complete company generation
 // 2. Valid CNPJ
 // 3. Name uniqueness
+// 4. Legal nature consistent with the name suffix, size and Simples Nacional
+// 5. CNAE activities, registration status and share capital
+// 6. Partners (QSA) following the legal nature
+// 7. Options (state, CNAE, size) and consistency of phone, address and email
 
 func TestGenerateCompany_Complete(t *testing.T) {
 	ds, err := NewDataStore()
@@ -59,3 +66,146 @@ func TestGenerateCompany_NameUniqueness(t *testing.T) {
 
 	assert.GreaterOrEqual(t, len(names), 45, "Should generate at least 45 unique company names out of 50 (found %d unique)", len(names))
 }
+
+func TestGenerateCompany_LegalNature(t *testing.T) {
+	gen := newTestGenerator(t)
+	natureBySuffix := map[string][]string{
+		"LTDA":   {"206-2"},
+		"SLU":    {"206-2"},
+		"EIRELI": {"230-5"},
+		"S.A.":   {"204-6", "205-4"},
+	}
+
+	for i := 0; i < 300; i++ {
+		company := gen.GenerateCompany()
+		suffix := company.Name[strings.LastIndex(company.Name, " ")+1:]
+
+		assert.Contains(t, natureBySuffix[suffix], company.LegalNature.Code, company.Name)
+		if suffix == "S.A." {
+			assert.Equal(t, CompanySizeOther, company.Size, "Corporations cannot be ME or EPP")
+		}
+		if company.Size == CompanySizeOther {
+			assert.False(t, company.SimplesNacional, "Only ME and EPP may opt for the Simples Nacional")
+		}
+		if suffix == "EIRELI" {
+			assert.GreaterOrEqual(t, company.FoundedAt, "2012-01-09")
+			assert.LessOrEqual(t, company.FoundedAt, "2021-08-26")
+		}
+		if suffix == "SLU" {
+			assert.GreaterOrEqual(t, company.FoundedAt, "2019-09-20")
+		}
+	}
+}
+
+func TestGenerateCompany_Registration(t *testing.T) {
+	gen := newTestGenerator(t)
+	cnaeRegex := regexp.MustCompile(`^\d{4}-\d/\d{2}$`)
+	today := time.Now().Format("2006-01-02")
+
+	for i := 0; i < 200; i++ {
+		company := gen.GenerateCompany()
+
+		assert.Less(t, company.FoundedAt, today, "Company should be founded in the past")
+		assert.GreaterOrEqual(t, company.StatusDate, company.FoundedAt)
+		assert.LessOrEqual(t, company.StatusDate, today)
+		assert.Greater(t, company.ShareCapitalValue, 0.0)
+		assert.Equal(t, FormatBRL(company.ShareCapitalValue), company.ShareCapital)
+
+		assert.Regexp(t, cnaeRegex, company.MainActivity.Code)
+		assert.LessOrEqual(t, len(company.SecondaryActivities), 4)
+		seen := map[string]bool{company.MainActivity.Code: true}
+		for _, activity := range company.SecondaryActivities {
+			assert.Regexp(t, cnaeRegex, activity.Code)
+			assert.False(t, seen[activity.Code], "Activities should not repeat")
+			seen[activity.Code] = true
+		}
+	}
+}
+
+func TestGenerateCompany_Partners(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 200; i++ {
+		company := gen.GenerateCompany()
+		suffix := company.Name[strings.LastIndex(company.Name, " ")+1:]
+
+		switch suffix {
+		case "LTDA":
+			assert.GreaterOrEqual(t, len(company.Partners), 2)
+			assert.Equal(t, "49", company.Partners[0].QualificationCode, "The first partner administers the company")
+		case "SLU", "EIRELI":
+			assert.Len(t, company.Partners, 1)
+		case "S.A.":
+			assert.Equal(t, "16", company.Partners[0].QualificationCode, "Corporations are led by a president")
+		}
+
+		total := 0.0
+		for _, partner := range company.Partners {
+			assert.True(t, ValidateCPF(partner.CPF), partner.CPF)
+			assert.NotEmpty(t, partner.Qualification)
+			assert.GreaterOrEqual(t, partner.EntryDate, company.FoundedAt)
+			total += partner.Share
+		}
+		if suffix == "S.A." {
+			assert.Zero(t, total, "Corporations do not list quotas")
+		} else {
+			assert.Equal(t, 100.0, total, "Quotas should add up to 100%%")
+		}
+	}
+}
+
+func TestSplitShares(t *testing.T) {
+	for count := 1; count <= 6; count++ {
+		shares := splitShares(count)
+		assert.Len(t, shares, count)
+
+		total := 0.0
+		for _, share := range shares {
+			assert.Greater(t, share, 0.0)
+			total += share
+		}
+		assert.Equal(t, 100.0, total)
+	}
+}
+
+func TestGenerateCompanyWithOptions(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		company := gen.GenerateCompanyWithOptions(CompanyOptions{State: "BA", Activity: "4930", Size: CompanySizeME})
+
+		assert.Equal(t, "BA", company.Address.State)
+		assert.Equal(t, "4930", strings.ReplaceAll(company.MainActivity.Code, "-", "")[:4])
+		assert.Equal(t, CompanySizeME, company.Size)
+		assert.NotContains(t, company.Name, "S.A.", "Micro companies cannot be corporations")
+	}
+}
+
+func TestGenerateCompany_PhoneFollowsCity(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 100; i++ {
+		company := gen.GenerateCompany()
+
+		ddd := company.Phone[1:3]
+		assert.Equal(t, gen.dataStore.GetDDDForCity(company.Address.State, company.Address.City), ddd, company.Address.City)
+	}
+}
+
+func TestGenerateCompany_CorporateEmail(t *testing.T) {
+	gen := newTestGenerator(t)
+	nonAlphanumeric := regexp.MustCompile(`[^a-z0-9]`)
+
+	for i := 0; i < 100; i++ {
+		company := gen.GenerateCompany()
+
+		tradeName := nonAlphanumeric.ReplaceAllString(strings.ToLower(removeAccents(company.TradeName)), "")
+		assert.True(t, strings.HasPrefix(company.Domain, tradeName+"."), "%s should derive from %s", company.Domain, company.TradeName)
+		assert.True(t, strings.HasSuffix(company.Email, "@"+company.Domain), company.Email)
+	}
+}
+
+func TestGenerateCompanyDomain(t *testing.T) {
+	domain := generateCompanyDomain("Conceição Soluções")
+	assert.Regexp(t, `^conceicaosolucoes\.com(\.br)?$`, domain)
+}
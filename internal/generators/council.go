@@ -0,0 +1,248 @@
+package generators
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Professional councils of the regulated professions
+const (
+	CouncilCRM   = "CRM"
+	CouncilOAB   = "OAB"
+	CouncilCREA  = "CREA"
+	CouncilCRC   = "CRC"
+	CouncilCOREN = "COREN"
+)
+
+// councilStates is the alternation of the state codes used by the registration patterns
+const councilStates = `(AC|AL|AP|AM|BA|CE|DF|ES|GO|MA|MT|MS|MG|PA|PB|PR|PE|PI|RJ|RN|RS|RO|RR|SC|SP|SE|TO)`
+
+// Categories of the councils that register them with the number
+var (
+	crcCategories   = []string{"O", "S", "T"}
+	corenCategories = []string{"ENF", "TE", "AE"}
+)
+
+// council describes the registration format of a professional council
+// Numbers are generated between minNumber and maxNumber; the pattern captures the state,
+// the number and, when the council has them, the category
+type council struct {
+	name       string
+	example    string
+	minNumber  int64
+	maxNumber  int64
+	categories []string
+	pattern    *regexp.Regexp
+	format     func(state, number, category string) string
+}
+
+// councils contains the registration formats by council code
+var councils = map[string]council{
+	CouncilCRM: {
+		name:      "Conselho Regional de Medicina",
+		example:   "CRM/SP 123456",
+		minNumber: 1000,
+		maxNumber: 299999,
+		pattern:   regexp.MustCompile(`^CRM[/ -]` + councilStates + ` ?(\d{4,6})()$`),
+		format: func(state, number, _ string) string {
+			return fmt.Sprintf("CRM/%s %s", state, number)
+		},
+	},
+	CouncilOAB: {
+		name:      "Ordem dos Advogados do Brasil",
+		example:   "OAB/SP 123.456",
+		minNumber: 1000,
+		maxNumber: 499999,
+		pattern:   regexp.MustCompile(`^OAB[/ -]` + councilStates + ` ?(\d{1,3}\.?\d{3})()$`),
+		format: func(state, number, _ string) string {
+			return fmt.Sprintf("OAB/%s %s", state, formatThousands(number))
+		},
+	},
+	CouncilCREA: {
+		name:      "Conselho Regional de Engenharia e Agronomia",
+		example:   "CREA-SP 5061234567",
+		minNumber: 1000000000,
+		maxNumber: 9999999999,
+		pattern:   regexp.MustCompile(`^CREA[/ -]` + councilStates + ` ?(\d{6,10})(?:/D)?()$`),
+		format: func(state, number, _ string) string {
+			return fmt.Sprintf("CREA-%s %s", state, number)
+		},
+	},
+	CouncilCRC: {
+		name:       "Conselho Regional de Contabilidade",
+		example:    "CRC/SP 123456/O",
+		minNumber:  1000,
+		maxNumber:  399999,
+		categories: crcCategories,
+		pattern:    regexp.MustCompile(`^CRC[/ -]` + councilStates + `[ -]?(\d{4,6})/` + alternation(crcCategories) + `(?:-\d)?$`),
+		format: func(state, number, category string) string {
+			return fmt.Sprintf("CRC/%s %s/%s", state, number, category)
+		},
+	},
+	CouncilCOREN: {
+		name:       "Conselho Regional de Enfermagem",
+		example:    "COREN-SP 123.456-ENF",
+		minNumber:  1000,
+		maxNumber:  999999,
+		categories: corenCategories,
+		pattern:    regexp.MustCompile(`^COREN[/ -]` + councilStates + ` ?(\d{1,3}\.?\d{3})-` + alternation(corenCategories) + `$`),
+		format: func(state, number, category string) string {
+			return fmt.Sprintf("COREN-%s %s-%s", state, formatThousands(number), category)
+		},
+	},
+}
+
+// alternation returns a capturing group matching any of the values, so patterns accept only the generated categories
+func alternation(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = regexp.QuoteMeta(value)
+	}
+	return "(" + strings.Join(quoted, "|") + ")"
+}
+
+// Errors returned by ParseCouncilRegistration
+var (
+	ErrCouncilUnknown = errors.New("registration has an unknown council")
+	ErrCouncilFormat  = errors.New("registration does not match the format of the council")
+)
+
+// CouncilRegistration contains the parts of a professional council registration
+type CouncilRegistration struct {
+	Council  string
+	State    string
+	Number   string
+	Category string
+}
+
+// GetCouncilCodes returns the sorted codes of the supported councils
+func GetCouncilCodes() []string {
+	codes := make([]string, 0, len(councils))
+	for code := range councils {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// IsValidCouncil reports whether the value is the code of a supported council
+func IsValidCouncil(code string) bool {
+	_, ok := councils[code]
+	return ok
+}
+
+// GenerateCouncilRegistration generates a professional registration in a council of a state
+// A random council and state are used when they are empty, and the profession is one regulated by the council
+func (g *Generator) GenerateCouncilRegistration(councilCode, stateCode string) *models.CouncilRegistrationResponse {
+	ds := g.dataStore
+
+	if !IsValidCouncil(councilCode) {
+		codes := GetCouncilCodes()
+		councilCode = codes[rand.Intn(len(codes))]
+	}
+	state := ds.GetStateByCode(stateCode)
+	if state == nil {
+		state = ds.GetRandomState()
+	}
+
+	professions := ds.GetCouncilProfessions(councilCode)
+	profession := ProfessionData{Council: councilCode}
+	if len(professions) > 0 {
+		profession = professions[rand.Intn(len(professions))]
+	}
+
+	registration := generateCouncilRegistration(profession, state.Code)
+
+	return &models.CouncilRegistrationResponse{
+		Registration: registration.Registration,
+		Council:      registration.Council,
+		CouncilName:  councils[registration.Council].name,
+		State:        registration.State,
+		Number:       registration.Number,
+		Category:     registration.Category,
+		Profession:   profession.Title,
+	}
+}
+
+// generateCouncilRegistration generates the registration of a regulated profession in the given state
+// It returns nil for professions without a council
+func generateCouncilRegistration(profession ProfessionData, stateCode string) *models.PersonCouncilRegistration {
+	council, ok := councils[profession.Council]
+	if !ok {
+		return nil
+	}
+
+	number := fmt.Sprintf("%d", council.minNumber+rand.Int63n(council.maxNumber-council.minNumber+1))
+	category := profession.CouncilCategory
+	if category == "" && len(council.categories) > 0 {
+		// Most registrations are the original one in the state (first category)
+		category = council.categories[0]
+		if rand.Intn(100) < 15 {
+			category = council.categories[1+rand.Intn(len(council.categories)-1)]
+		}
+	}
+
+	return &models.PersonCouncilRegistration{
+		Registration: council.format(stateCode, number, category),
+		Council:      profession.Council,
+		State:        stateCode,
+		Number:       number,
+		Category:     category,
+	}
+}
+
+// formatThousands separates the thousands of a number with a dot (ex: 123.456)
+func formatThousands(number string) string {
+	if len(number) <= 3 {
+		return number
+	}
+	return number[:len(number)-3] + "." + number[len(number)-3:]
+}
+
+// ParseCouncilRegistration parses a professional council registration (ex: CRM/SP 123456)
+// The council and the state may be separated by a slash, a dash or a space, and case and extra spaces are ignored
+func ParseCouncilRegistration(registration string) (*CouncilRegistration, error) {
+	clean := strings.ToUpper(strings.Join(strings.Fields(registration), " "))
+
+	code := clean
+	if i := strings.IndexAny(clean, "/- "); i >= 0 {
+		code = clean[:i]
+	}
+	council, ok := councils[code]
+	if !ok {
+		return nil, fmt.Errorf("%w (%s)", ErrCouncilUnknown, strings.Join(GetCouncilCodes(), ", "))
+	}
+
+	matches := council.pattern.FindStringSubmatch(clean)
+	if matches == nil {
+		return nil, fmt.Errorf("%w (ex: %s)", ErrCouncilFormat, council.example)
+	}
+
+	return &CouncilRegistration{
+		Council:  code,
+		State:    matches[1],
+		Number:   strings.ReplaceAll(matches[2], ".", ""),
+		Category: matches[3],
+	}, nil
+}
+
+// FormatCouncilRegistration formats the parts of a registration in the usual format of the council
+func FormatCouncilRegistration(registration *CouncilRegistration) string {
+	council, ok := councils[registration.Council]
+	if !ok {
+		return ""
+	}
+	return council.format(registration.State, registration.Number, registration.Category)
+}
+
+// ValidateCouncilRegistration checks if a registration matches the format of its council
+func ValidateCouncilRegistration(registration string) bool {
+	_, err := ParseCouncilRegistration(registration)
+	return err == nil
+}
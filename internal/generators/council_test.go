@@ -0,0 +1,166 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for professional council registrations focusing on:
+// 1. Format of the generated registrations of each council
+// 2. Category of the registration following the profession
+// 3. Parsing and validation of the format per council
+// 4. Registrations of persons with regulated professions
+
+func TestGenerateCouncilRegistration_AllCouncils(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for _, code := range GetCouncilCodes() {
+		for i := 0; i < 50; i++ {
+			registration := gen.GenerateCouncilRegistration(code, "RJ")
+
+			assert.Equal(t, code, registration.Council)
+			assert.Equal(t, "RJ", registration.State)
+			assert.NotEmpty(t, registration.CouncilName)
+			assert.NotEmpty(t, registration.Profession)
+			assert.True(t, ValidateCouncilRegistration(registration.Registration), registration.Registration)
+
+			parsed, err := ParseCouncilRegistration(registration.Registration)
+			if assert.NoError(t, err) {
+				assert.Equal(t, registration.Number, parsed.Number)
+				assert.Equal(t, registration.Category, parsed.Category)
+			}
+		}
+	}
+}
+
+func TestGenerateCouncilRegistration_RandomCouncilAndState(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	registration := gen.GenerateCouncilRegistration("", "")
+	assert.True(t, IsValidCouncil(registration.Council))
+	assert.NotNil(t, gen.dataStore.GetStateByCode(registration.State))
+}
+
+func TestGenerateCouncilRegistration_Formats(t *testing.T) {
+	tests := []struct {
+		profession ProfessionData
+		pattern    string
+	}{
+		{ProfessionData{Title: "Médico", Council: CouncilCRM}, `^CRM/SP \d{4,6}$`},
+		{ProfessionData{Title: "Advogado", Council: CouncilOAB}, `^OAB/SP \d{1,3}\.\d{3}$`},
+		{ProfessionData{Title: "Engenheiro Civil", Council: CouncilCREA}, `^CREA-SP \d{10}$`},
+		{ProfessionData{Title: "Contador", Council: CouncilCRC}, `^CRC/SP \d{4,6}/[OST]$`},
+		{ProfessionData{Title: "Técnico em Enfermagem", Council: CouncilCOREN, CouncilCategory: "TE"}, `^COREN-SP \d{1,3}\.\d{3}-TE$`},
+	}
+
+	for _, tt := range tests {
+		registration := generateCouncilRegistration(tt.profession, "SP")
+		if assert.NotNil(t, registration, tt.profession.Title) {
+			assert.Regexp(t, tt.pattern, registration.Registration)
+		}
+	}
+
+	assert.Nil(t, generateCouncilRegistration(ProfessionData{Title: "Desenvolvedor"}, "SP"))
+}
+
+func TestParseCouncilRegistration(t *testing.T) {
+	tests := []struct {
+		registration string
+		council      string
+		state        string
+		number       string
+		category     string
+	}{
+		{"CRM/SP 123456", "CRM", "SP", "123456", ""},
+		{"crm-rj 5432", "CRM", "RJ", "5432", ""},
+		{"CRM SP 123456", "CRM", "SP", "123456", ""},
+		{"OAB/MG 123.456", "OAB", "MG", "123456", ""},
+		{"OAB/MG 98765", "OAB", "MG", "98765", ""},
+		{"CREA-PR 1234567890", "CREA", "PR", "1234567890", ""},
+		{"CREA-SP 123456/D", "CREA", "SP", "123456", ""},
+		{"CRC/BA 12345/O", "CRC", "BA", "12345", "O"},
+		{"CRC-SP 123456/O-7", "CRC", "SP", "123456", "O"},
+		{"COREN-SP 123.456-ENF", "COREN", "SP", "123456", "ENF"},
+		{"  coren/df   4567-ae ", "COREN", "DF", "4567", "AE"},
+	}
+
+	for _, tt := range tests {
+		parsed, err := ParseCouncilRegistration(tt.registration)
+		if assert.NoError(t, err, tt.registration) {
+			assert.Equal(t, tt.council, parsed.Council, tt.registration)
+			assert.Equal(t, tt.state, parsed.State, tt.registration)
+			assert.Equal(t, tt.number, parsed.Number, tt.registration)
+			assert.Equal(t, tt.category, parsed.Category, tt.registration)
+		}
+	}
+}
+
+func TestParseCouncilRegistration_Invalid(t *testing.T) {
+	tests := []struct {
+		registration string
+		err          error
+	}{
+		{"CRO/SP 12345", ErrCouncilUnknown},
+		{"", ErrCouncilUnknown},
+		{"CRM/XX 123456", ErrCouncilFormat},
+		{"CRM/SP 12", ErrCouncilFormat},
+		{"CRM/SP 1234567", ErrCouncilFormat},
+		{"OAB/SP 12.34", ErrCouncilFormat},
+		{"CREA-SP 12345", ErrCouncilFormat},
+		{"CRC/SP 123456", ErrCouncilFormat},
+		{"CRC/SP 123456/X", ErrCouncilFormat},
+		{"CRC/SP 123456/P", ErrCouncilFormat},
+		{"COREN-SP 123.456", ErrCouncilFormat},
+		{"COREN-SP 123.456-MED", ErrCouncilFormat},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseCouncilRegistration(tt.registration)
+		assert.True(t, errors.Is(err, tt.err), "%q: %v", tt.registration, err)
+		assert.False(t, ValidateCouncilRegistration(tt.registration), tt.registration)
+	}
+}
+
+func TestFormatCouncilRegistration(t *testing.T) {
+	parsed, err := ParseCouncilRegistration("coren sp 123456-enf")
+	assert.NoError(t, err)
+	assert.Equal(t, "COREN-SP 123.456-ENF", FormatCouncilRegistration(parsed))
+
+	parsed, err = ParseCouncilRegistration("OAB-RJ 1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "OAB/RJ 1.234", FormatCouncilRegistration(parsed))
+}
+
+func TestGeneratePerson_CouncilRegistration(t *testing.T) {
+	gen := newTestGenerator(t)
+	registered := 0
+
+	for i := 0; i < 300; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{ProfessionArea: "Saúde"})
+		if person.Profession == nil {
+			continue
+		}
+
+		var council string
+		for _, profession := range gen.dataStore.GetProfessions() {
+			if profession.Title == person.Profession.Title {
+				council = profession.Council
+			}
+		}
+
+		if council == "" {
+			assert.Nil(t, person.Profession.Registration, person.Profession.Title)
+			continue
+		}
+		if assert.NotNil(t, person.Profession.Registration, person.Profession.Title) {
+			registered++
+			assert.Equal(t, council, person.Profession.Registration.Council)
+			assert.Equal(t, person.Address.State, person.Profession.Registration.State, "The registration follows the state where the person lives")
+			assert.True(t, ValidateCouncilRegistration(person.Profession.Registration.Registration))
+		}
+	}
+
+	assert.Greater(t, registered, 0, "Some health professionals should be registered in a council")
+}
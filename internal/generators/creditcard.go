@@ -5,6 +5,8 @@ import (
 	"math/rand"
 	"strings"
 	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
 )
 
 // capitalizeFirst capitalizes the first letter of a string
@@ -16,7 +18,16 @@ func capitalizeFirst(s string) string {
 }
 
 // GenerateCreditCard generates fake credit card data
+// The holder name is a random person name embossed as printed on cards
 func (g *Generator) GenerateCreditCard(brand string) (number, cardBrand, cvv, expirationDate, holderName string) {
+	genders := []string{"male", "female"}
+	holderName = EmbossedName(g.generatePersonName(genders[rand.Intn(len(genders))], nameProfile{}))
+	number, cardBrand, cvv, expirationDate = generateCardData(brand)
+	return
+}
+
+// generateCardData generates the number, brand, CVV and expiration date of a card
+func generateCardData(brand string) (number, cardBrand, cvv, expirationDate string) {
 	brands := []string{"visa", "mastercard", "elo", "amex"}
 
 	if brand != "" {
@@ -41,10 +52,38 @@ func (g *Generator) GenerateCreditCard(brand string) (number, cardBrand, cvv, ex
 	expirationMonth := 1 + rand.Intn(12)
 	expirationDate = fmt.Sprintf("%02d/%02d", expirationMonth, expirationYear%100)
 
-	ds := g.dataStore
-	firstName := ds.GetRandomMaleFirstName() // Simplified
-	lastName := ds.GetRandomLastName()
-	holderName = fmt.Sprintf("%s %s", firstName, lastName)
-
 	return
 }
+
+// maxEmbossedNameLength is the maximum number of characters embossed on a card
+const maxEmbossedNameLength = 26
+
+// nameConnectors are the particles ignored when abbreviating names
+var nameConnectors = map[string]bool{"da": true, "das": true, "de": true, "do": true, "dos": true, "e": true}
+
+// EmbossedName formats a name as embossed on cards: uppercase, without accents,
+// keeping the first and last names and abbreviating the middle names (ex: MARIA F S LIMA)
+func EmbossedName(name models.PersonName) string {
+	words := strings.Fields(strings.ToUpper(removeAccents(name.FullName)))
+	if len(words) <= 2 {
+		return strings.Join(words, " ")
+	}
+
+	first := words[0]
+	last := words[len(words)-1]
+
+	parts := []string{first}
+	for _, word := range words[1 : len(words)-1] {
+		if nameConnectors[strings.ToLower(word)] {
+			continue
+		}
+		parts = append(parts, word[:1])
+	}
+	parts = append(parts, last)
+
+	embossed := strings.Join(parts, " ")
+	if len(embossed) > maxEmbossedNameLength {
+		embossed = first + " " + last
+	}
+	return embossed
+}
@@ -6,6 +6,8 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -14,23 +16,47 @@ import (
 
 // DataStore stores all the necessary data for generation
 type DataStore struct {
-	maleFirstNames   []string
-	femaleFirstNames []string
-	lastNames        []string
-	colors           []string
-	bloodTypes       []string
-	zodiacSigns      []ZodiacSign
-	states           []StateData
-	stateMap         map[string]*StateData
-	dddData          []DDDData
-	dddMap           map[string][]int
-	professions      []ProfessionData
-	educationLevels  []string
-	maritalStatuses  []string
-	realAddresses    map[string][]RealAddress
-	emailShortNames  []string
-	emailExtensions  []string
-	mu               sync.RWMutex
+	maleFirstNames    []string
+	femaleFirstNames  []string
+	neutralFirstNames []string
+	lastNames         []string
+	firstNameTables   map[nameTableKey]*weightedNames
+	lastNameTables    map[string]*weightedNames
+	nameDecades       []int
+	colors            []string
+	bloodTypes        []string
+	zodiacSigns       []ZodiacSign
+	states            []StateData
+	stateMap          map[string]*StateData
+	dddData           []DDDData
+	dddMap            map[string][]int
+	cityDDDs          map[string]string
+	professions       []ProfessionData
+	salaryRanges      map[string]SalaryRange
+	educationFactors  map[string]float64
+	minimumWage       float64
+	educationLevels   []string
+	educationMinAges  map[string]int
+	minorEducation    []MinorEducation
+	maritalStatuses   []string
+	maritalMinAges    map[string]int
+	realAddresses     map[string][]RealAddress
+	cityAddresses     map[string][]RealAddress
+	cityNames         map[string]string
+	cityIBGECodes     map[string]string
+	zipcodes          map[string]ZipcodeRecord
+	spatial           *spatialIndex
+	streets           *streetIndex
+	stateAddresses    map[string]StateAddressData
+	emailShortNames   []string
+	emailExtensions   []string
+	countries         []CountryData
+	countryMap        map[string]*CountryData
+	countryTable      *aliasTable
+	activities        []ActivityData
+	legalNatures      []LegalNatureData
+	qualifications    map[string]string
+	mu                sync.RWMutex
 }
 
 // ZodiacSign represents a zodiac sign
@@ -41,17 +67,96 @@ type ZodiacSign struct {
 }
 
 // ProfessionData represents data of a profession
+// SalaryMin and SalaryMax override the area salary range when set
+// Council is set for regulated professions, with the registration category when the council has several
 type ProfessionData struct {
-	Title string `json:"title"`
-	Area  string `json:"area"`
+	Title           string  `json:"title"`
+	Area            string  `json:"area"`
+	SalaryMin       float64 `json:"salaryMin,omitempty"`
+	SalaryMax       float64 `json:"salaryMax,omitempty"`
+	Council         string  `json:"council,omitempty"`
+	CouncilCategory string  `json:"councilCategory,omitempty"`
+}
+
+// MinorEducation represents the education level in progress from a given age
+type MinorEducation struct {
+	MinAge    int    `json:"minAge"`
+	Education string `json:"education"`
+}
+
+// CountryData represents a country of origin of foreign residents
+// Weight is the share of the country among the foreign residents in Brazil
+type CountryData struct {
+	Code           string   `json:"code"`
+	Name           string   `json:"name"`
+	Nationality    string   `json:"nationality"`
+	Weight         float64  `json:"weight"`
+	SurnameStyle   string   `json:"surnameStyle"`
+	PassportFormat string   `json:"passportFormat"`
+	Cities         []string `json:"cities"`
+	FirstNames     struct {
+		Male   []string `json:"male"`
+		Female []string `json:"female"`
+	} `json:"firstNames"`
+	LastNames []string `json:"lastNames"`
+}
+
+// foreignData struct to deserialize foreign countries data
+type foreignData struct {
+	Countries []CountryData `json:"countries"`
+}
+
+// ActivityData represents an economic activity of the CNAE (Classificação Nacional de Atividades Econômicas)
+// Areas are the profession areas of the core staff of companies of the activity, and MEI tells whether
+// the activity may be exercised by a microempreendedor individual
+type ActivityData struct {
+	Code        string   `json:"code"`
+	Description string   `json:"description"`
+	Areas       []string `json:"areas"`
+	MEI         bool     `json:"mei,omitempty"`
+}
+
+// LegalNatureData represents a legal nature (natureza jurídica) of companies
+// Suffix is the ending of the company name and Quotas tells whether the capital is divided in quotas among
+// the partners; the partner counts and qualification codes describe the QSA (quadro de sócios e
+// administradores), and Since and Until bound the foundation date
+type LegalNatureData struct {
+	Code          string  `json:"code"`
+	Description   string  `json:"description"`
+	Suffix        string  `json:"suffix"`
+	Weight        float64 `json:"weight"`
+	MinPartners   int     `json:"minPartners"`
+	MaxPartners   int     `json:"maxPartners"`
+	Administrator string  `json:"administrator"`
+	Member        string  `json:"member,omitempty"`
+	Quotas        bool    `json:"quotas,omitempty"`
+	Since         string  `json:"since,omitempty"`
+	Until         string  `json:"until,omitempty"`
+}
+
+// companyData struct to deserialize company data
+type companyData struct {
+	Activities     []ActivityData    `json:"activities"`
+	LegalNatures   []LegalNatureData `json:"legalNatures"`
+	Qualifications map[string]string `json:"qualifications"`
+}
+
+// SalaryRange represents a monthly salary range in BRL
+type SalaryRange struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
 }
 
 // StateData contains information about a state
 type StateData struct {
-	Code   string   `json:"code"`
-	Name   string   `json:"name"`
-	DDD    string   `json:"ddd"`
-	Cities []string `json:"cities"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+	DDD  string `json:"ddd"`
+	// IBGECode is the 2-digit IBGE code of the state, the prefix of the IBGE codes of its cities
+	IBGECode string   `json:"ibgeCode"`
+	Region   string   `json:"region"`
+	Capital  string   `json:"capital"`
+	Cities   []string `json:"cities"`
 }
 
 // DDDData contains information about DDD by state
@@ -73,18 +178,29 @@ type RealAddress struct {
 	Longitude   string `json:"longitude"`
 }
 
+// ZipcodeRecord is a real address found by its CEP, with the codes of its state and city
+type ZipcodeRecord struct {
+	RealAddress
+	StateCode    string
+	CityIBGECode string
+	CityDDD      string
+}
+
 // StateAddressData represents the structure of the JSON file of a state
 type StateAddressData struct {
-	Code   string     `json:"code"`
-	Name   string     `json:"name"`
-	DDD    string     `json:"ddd"`
-	Cities []CityData `json:"cities"`
+	Code    string     `json:"code"`
+	Name    string     `json:"name"`
+	DDD     string     `json:"ddd"`
+	Region  string     `json:"regiao"`
+	Capital string     `json:"capital"`
+	Cities  []CityData `json:"cities"`
 }
 
 // CityData represents a city in the JSON file
 type CityData struct {
 	Name      string         `json:"name"`
 	IBGECode  string         `json:"ibgeCode"`
+	DDD       string         `json:"ddd"`
 	Districts []DistrictData `json:"districts"`
 }
 
@@ -94,19 +210,104 @@ type DistrictData struct {
 	Streets []RealAddress `json:"streets"`
 }
 
+// nameFrequencyData contains the frequency of names (e.g. census counts)
+// Names without a count get the default weight
+type nameFrequencyData struct {
+	Default float64            `json:"default"`
+	Counts  map[string]float64 `json:"counts"`
+}
+
+// nameFrequencies contains the name frequencies and their regional and generational multipliers
+type nameFrequencies struct {
+	Male      nameFrequencyData `json:"male"`
+	Female    nameFrequencyData `json:"female"`
+	LastNames nameFrequencyData `json:"lastNames"`
+	// Regions maps a region to first name and last name weight multipliers
+	Regions map[string]regionNameFactors `json:"regions"`
+	// Decades maps a birth decade to first name weight multipliers
+	Decades map[string]map[string]float64 `json:"decades"`
+}
+
+// regionNameFactors contains the weight multipliers of a region, kept apart for first names and last names
+// since some names are both (ex: Francisco)
+type regionNameFactors struct {
+	FirstNames map[string]float64 `json:"firstNames"`
+	LastNames  map[string]float64 `json:"lastNames"`
+}
+
+// nameTableKey identifies a first name table by gender, region and birth decade
+// Empty region and zero decade mean the national table over all ages
+type nameTableKey struct {
+	gender string
+	region string
+	decade int
+}
+
+// weightedNames holds unique names and an alias table over their frequencies
+type weightedNames struct {
+	names []string
+	table *aliasTable
+}
+
+// newWeightedNames builds a weighted name list, removing duplicated names
+// Each factor map multiplies the weight of the names it contains
+func newWeightedNames(names []string, frequencies nameFrequencyData, factors ...map[string]float64) *weightedNames {
+	seen := make(map[string]bool, len(names))
+	unique := make([]string, 0, len(names))
+	weights := make([]float64, 0, len(names))
+
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		weight, ok := frequencies.Counts[name]
+		if !ok {
+			weight = frequencies.Default
+		}
+		for _, factor := range factors {
+			if multiplier, ok := factor[name]; ok {
+				weight *= multiplier
+			}
+		}
+		unique = append(unique, name)
+		weights = append(weights, weight)
+	}
+
+	return &weightedNames{
+		names: unique,
+		table: newAliasTable(weights),
+	}
+}
+
+// pick returns a name following the frequencies
+func (w *weightedNames) pick() string {
+	return w.names[w.table.pick()]
+}
+
 // personData struct to deserialize person data
 type personData struct {
 	FirstNames struct {
 		Male   []string `json:"male"`
 		Female []string `json:"female"`
+		// Neutral contains unisex names, used as social names of non-binary persons
+		Neutral []string `json:"neutral"`
 	} `json:"firstNames"`
-	LastNames       []string         `json:"lastNames"`
-	Colors          []string         `json:"colors"`
-	BloodTypes      []string         `json:"bloodTypes"`
-	ZodiacSigns     []ZodiacSign     `json:"zodiacSigns"`
-	Professions     []ProfessionData `json:"professions"`
-	EducationLevels []string         `json:"educationLevels"`
-	MaritalStatuses []string         `json:"maritalStatuses"`
+	LastNames              []string               `json:"lastNames"`
+	NameFrequencies        nameFrequencies        `json:"nameFrequencies"`
+	Colors                 []string               `json:"colors"`
+	BloodTypes             []string               `json:"bloodTypes"`
+	ZodiacSigns            []ZodiacSign           `json:"zodiacSigns"`
+	Professions            []ProfessionData       `json:"professions"`
+	SalaryRanges           map[string]SalaryRange `json:"salaryRanges"`
+	EducationLevels        []string               `json:"educationLevels"`
+	EducationSalaryFactors map[string]float64     `json:"educationSalaryFactors"`
+	EducationMinAges       map[string]int         `json:"educationMinAges"`
+	MinorEducation         []MinorEducation       `json:"minorEducation"`
+	MaritalStatuses        []string               `json:"maritalStatuses"`
+	MaritalStatusMinAges   map[string]int         `json:"maritalStatusMinAges"`
+	MinimumWage            float64                `json:"minimumWage"`
 }
 
 // emailData struct to deserialize email data
@@ -172,9 +373,18 @@ func getDataPath(filename string) string {
 // Validate that all necessary files exist and contain valid data
 func NewDataStore() (*DataStore, error) {
 	ds := &DataStore{
-		stateMap:      make(map[string]*StateData),
-		dddMap:        make(map[string][]int),
-		realAddresses: make(map[string][]RealAddress),
+		stateMap:       make(map[string]*StateData),
+		dddMap:         make(map[string][]int),
+		cityDDDs:       make(map[string]string),
+		realAddresses:  make(map[string][]RealAddress),
+		cityAddresses:  make(map[string][]RealAddress),
+		cityNames:      make(map[string]string),
+		cityIBGECodes:  make(map[string]string),
+		zipcodes:       make(map[string]ZipcodeRecord),
+		spatial:        newSpatialIndex(),
+		streets:        newStreetIndex(),
+		stateAddresses: make(map[string]StateAddressData),
+		countryMap:     make(map[string]*CountryData),
 	}
 
 	// Load person data
@@ -197,6 +407,16 @@ func NewDataStore() (*DataStore, error) {
 		return nil, fmt.Errorf("error loading email data: %w", err)
 	}
 
+	// Load countries of foreign residents
+	if err := ds.loadForeignData(); err != nil {
+		return nil, fmt.Errorf("error loading foreign data: %w", err)
+	}
+
+	// Load company data
+	if err := ds.loadCompanyData(); err != nil {
+		return nil, fmt.Errorf("error loading company data: %w", err)
+	}
+
 	// Validate that all necessary data has been loaded
 	if err := ds.validateRequiredData(); err != nil {
 		return nil, fmt.Errorf("data validation failed: %w", err)
@@ -222,6 +442,7 @@ func (ds *DataStore) validateRequiredData() error {
 		{"blood types", func() bool { return len(ds.bloodTypes) > 0 }, len(ds.bloodTypes)},
 		{"zodiac signs", func() bool { return len(ds.zodiacSigns) > 0 }, len(ds.zodiacSigns)},
 		{"professions", func() bool { return len(ds.professions) > 0 }, len(ds.professions)},
+		{"salary ranges", func() bool { return len(ds.salaryRanges) > 0 }, len(ds.salaryRanges)},
 		{"education levels", func() bool { return len(ds.educationLevels) > 0 }, len(ds.educationLevels)},
 		{"marital statuses", func() bool { return len(ds.maritalStatuses) > 0 }, len(ds.maritalStatuses)},
 		{"states", func() bool { return len(ds.states) > 0 }, len(ds.states)},
@@ -229,6 +450,11 @@ func (ds *DataStore) validateRequiredData() error {
 		{"real addresses", func() bool { return len(ds.realAddresses) > 0 }, len(ds.realAddresses)},
 		{"email short names", func() bool { return len(ds.emailShortNames) > 0 }, len(ds.emailShortNames)},
 		{"email extensions", func() bool { return len(ds.emailExtensions) > 0 }, len(ds.emailExtensions)},
+		{"minor education levels", func() bool { return len(ds.minorEducation) > 0 }, len(ds.minorEducation)},
+		{"foreign countries", func() bool { return len(ds.countries) > 0 }, len(ds.countries)},
+		{"economic activities", func() bool { return len(ds.activities) > 0 }, len(ds.activities)},
+		{"legal natures", func() bool { return len(ds.legalNatures) > 0 }, len(ds.legalNatures)},
+		{"MEI activities", func() bool { return len(ds.GetMEIActivities()) > 0 }, len(ds.GetMEIActivities())},
 	}
 
 	for _, validation := range validations {
@@ -242,11 +468,12 @@ func (ds *DataStore) validateRequiredData() error {
 		Int("female_names", validations[1].count).
 		Int("last_names", validations[2].count).
 		Int("professions", validations[6].count).
-		Int("states", validations[9].count).
-		Int("ddd_entries", validations[10].count).
-		Int("address_states", validations[11].count).
-		Int("email_extensions", validations[13].count).
-		Int("email_short_names", validations[12].count).
+		Int("salary_ranges", validations[7].count).
+		Int("states", validations[10].count).
+		Int("ddd_entries", validations[11].count).
+		Int("address_states", validations[12].count).
+		Int("email_extensions", validations[14].count).
+		Int("email_short_names", validations[13].count).
 		Msg("All required data validated successfully")
 
 	return nil
@@ -276,13 +503,24 @@ func (ds *DataStore) loadPersonData() error {
 
 	ds.maleFirstNames = data.FirstNames.Male
 	ds.femaleFirstNames = data.FirstNames.Female
+	ds.neutralFirstNames = data.FirstNames.Neutral
 	ds.lastNames = data.LastNames
+	if err := ds.buildNameTables(&data); err != nil {
+		log.Error().Err(err).Str("file", filePath).Msg("Failed to build name tables")
+		return err
+	}
 	ds.colors = data.Colors
 	ds.bloodTypes = data.BloodTypes
 	ds.zodiacSigns = data.ZodiacSigns
 	ds.professions = data.Professions
+	ds.salaryRanges = data.SalaryRanges
 	ds.educationLevels = data.EducationLevels
+	ds.educationFactors = data.EducationSalaryFactors
+	ds.educationMinAges = data.EducationMinAges
+	ds.minorEducation = data.MinorEducation
 	ds.maritalStatuses = data.MaritalStatuses
+	ds.maritalMinAges = data.MaritalStatusMinAges
+	ds.minimumWage = data.MinimumWage
 
 	log.Info().
 		Int("male_names", len(ds.maleFirstNames)).
@@ -308,6 +546,19 @@ func (ds *DataStore) GetRandomFemaleFirstName() string {
 	return ds.femaleFirstNames[rand.Intn(len(ds.femaleFirstNames))]
 }
 
+// GetRandomNeutralFirstName returns a random unisex first name
+// Falls back to a male or female name when no unisex names are loaded
+func (ds *DataStore) GetRandomNeutralFirstName() string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	if len(ds.neutralFirstNames) == 0 {
+		names := append(append([]string{}, ds.maleFirstNames...), ds.femaleFirstNames...)
+		return names[rand.Intn(len(names))]
+	}
+	return ds.neutralFirstNames[rand.Intn(len(ds.neutralFirstNames))]
+}
+
 // GetRandomLastName returns a random last name
 func (ds *DataStore) GetRandomLastName() string {
 	ds.mu.RLock()
@@ -315,6 +566,86 @@ func (ds *DataStore) GetRandomLastName() string {
 	return ds.lastNames[rand.Intn(len(ds.lastNames))]
 }
 
+// buildNameTables precomputes the weighted name tables for every region and birth decade
+func (ds *DataStore) buildNameTables(data *personData) error {
+	frequencies := data.NameFrequencies
+
+	decadeFactors := map[int]map[string]float64{0: nil}
+	ds.nameDecades = make([]int, 0, len(frequencies.Decades))
+	for key, factors := range frequencies.Decades {
+		decade, err := strconv.Atoi(key)
+		if err != nil || decade%10 != 0 {
+			return fmt.Errorf("invalid name decade %q", key)
+		}
+		decadeFactors[decade] = factors
+		ds.nameDecades = append(ds.nameDecades, decade)
+	}
+	sort.Ints(ds.nameDecades)
+
+	regionFactors := map[string]regionNameFactors{"": {}}
+	for region, factors := range frequencies.Regions {
+		regionFactors[region] = factors
+	}
+
+	ds.firstNameTables = make(map[nameTableKey]*weightedNames, 2*len(regionFactors)*len(decadeFactors))
+	ds.lastNameTables = make(map[string]*weightedNames, len(regionFactors))
+	for region, byRegion := range regionFactors {
+		for decade, byDecade := range decadeFactors {
+			ds.firstNameTables[nameTableKey{"male", region, decade}] = newWeightedNames(data.FirstNames.Male, frequencies.Male, byRegion.FirstNames, byDecade)
+			ds.firstNameTables[nameTableKey{"female", region, decade}] = newWeightedNames(data.FirstNames.Female, frequencies.Female, byRegion.FirstNames, byDecade)
+		}
+		// Surnames are inherited, so they only vary by region
+		ds.lastNameTables[region] = newWeightedNames(data.LastNames, frequencies.LastNames, byRegion.LastNames)
+	}
+
+	return nil
+}
+
+// nameDecade returns the closest birth decade with name weights, or zero when the year is unknown
+func (ds *DataStore) nameDecade(birthYear int) int {
+	if birthYear <= 0 || len(ds.nameDecades) == 0 {
+		return 0
+	}
+
+	decade := birthYear / 10 * 10
+	if decade < ds.nameDecades[0] {
+		return ds.nameDecades[0]
+	}
+	if decade > ds.nameDecades[len(ds.nameDecades)-1] {
+		return ds.nameDecades[len(ds.nameDecades)-1]
+	}
+	return decade
+}
+
+// GetWeightedFirstName returns a first name following the name frequencies of the region and birth year
+// An unknown region or a zero birth year fall back to the national frequencies
+func (ds *DataStore) GetWeightedFirstName(gender, region string, birthYear int) string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	if gender != "male" {
+		gender = "female"
+	}
+
+	decade := ds.nameDecade(birthYear)
+	if table, ok := ds.firstNameTables[nameTableKey{gender, region, decade}]; ok {
+		return table.pick()
+	}
+	return ds.firstNameTables[nameTableKey{gender, "", decade}].pick()
+}
+
+// GetWeightedLastName returns a last name following the surname frequencies of the region
+// An unknown region falls back to the national frequencies
+func (ds *DataStore) GetWeightedLastName(region string) string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	if table, ok := ds.lastNameTables[region]; ok {
+		return table.pick()
+	}
+	return ds.lastNameTables[""].pick()
+}
+
 // GetRandomColor returns a random favorite color
 func (ds *DataStore) GetRandomColor() string {
 	ds.mu.RLock()
@@ -361,6 +692,13 @@ func (ds *DataStore) GetRandomState() *StateData {
 	return &ds.states[rand.Intn(len(ds.states))]
 }
 
+// GetStates returns all the states
+func (ds *DataStore) GetStates() []StateData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.states
+}
+
 // GetStateByCode returns a state by code (ex: "SP")
 func (ds *DataStore) GetStateByCode(code string) *StateData {
 	ds.mu.RLock()
@@ -444,6 +782,132 @@ func (ds *DataStore) GetDDDForState(stateCode string) string {
 	return fmt.Sprintf("%02d", ddd)
 }
 
+// GetDDDForCity returns the DDD of a city, or a DDD of its state when the city is unknown
+func (ds *DataStore) GetDDDForCity(stateCode, city string) string {
+	if ddd, ok := ds.GetCityDDD(stateCode, city); ok {
+		return ddd
+	}
+	return ds.GetDDDForState(stateCode)
+}
+
+// GetCityDDD returns the DDD of a city, and false when the city has no known DDD
+func (ds *DataStore) GetCityDDD(stateCode, city string) (string, bool) {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	ddd, ok := ds.cityDDDs[cityKey(stateCode, city)]
+	return ddd, ok
+}
+
+// GetStateDDDs returns the DDDs of a state (ex: 11 to 19 for SP)
+func (ds *DataStore) GetStateDDDs(stateCode string) []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	codes := ds.dddMap[strings.ToUpper(stateCode)]
+	ddds := make([]string, 0, len(codes))
+	for _, code := range codes {
+		ddds = append(ddds, fmt.Sprintf("%02d", code))
+	}
+	return ddds
+}
+
+// cityKey builds the key of a city in the city indexes, ignoring case, accents and extra spaces
+func cityKey(stateCode, city string) string {
+	return strings.ToUpper(stateCode) + "/" + normalizePlaceName(city)
+}
+
+// normalizePlaceName lowercases the name of a city, district or street and removes its accents and extra spaces (ex: sao paulo)
+func normalizePlaceName(name string) string {
+	return strings.ToLower(removeAccents(strings.Join(strings.Fields(name), " ")))
+}
+
+// FindCity finds a city by name, ignoring case and accents, and returns its state code and canonical name
+// Without a state the city is searched in every state, and homonyms in several states are picked at random
+func (ds *DataStore) FindCity(stateCode, city string) (string, string, bool) {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	if stateCode != "" {
+		name, ok := ds.cityNames[cityKey(stateCode, city)]
+		return strings.ToUpper(stateCode), name, ok
+	}
+
+	matches := make([]string, 0)
+	for _, state := range ds.states {
+		if _, ok := ds.cityNames[cityKey(state.Code, city)]; ok {
+			matches = append(matches, state.Code)
+		}
+	}
+	if len(matches) == 0 {
+		return "", "", false
+	}
+	state := matches[rand.Intn(len(matches))]
+	return state, ds.cityNames[cityKey(state, city)], true
+}
+
+// GetCityIBGECode returns the 7-digit IBGE code of a city, or an empty string when the city is unknown
+func (ds *DataStore) GetCityIBGECode(stateCode, city string) string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.cityIBGECodes[cityKey(stateCode, city)]
+}
+
+// GetCapital returns the canonical name of the capital of a state, and false when the capital
+// is not among the cities of the address database
+func (ds *DataStore) GetCapital(stateCode string) (string, bool) {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	state, ok := ds.stateMap[strings.ToUpper(stateCode)]
+	if !ok || state.Capital == "" {
+		return "", false
+	}
+	name, ok := ds.cityNames[cityKey(state.Code, state.Capital)]
+	return name, ok
+}
+
+// IsCapital tells whether a city is the capital of the state, ignoring case and accents
+func (ds *DataStore) IsCapital(stateCode, city string) bool {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	state, ok := ds.stateMap[strings.ToUpper(stateCode)]
+	return ok && state.Capital != "" && normalizePlaceName(state.Capital) == normalizePlaceName(city)
+}
+
+// GetRegions returns the regions of the states, sorted by name (ex: Centro-Oeste, Nordeste)
+func (ds *DataStore) GetRegions() []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	regions := make([]string, 0)
+	for _, state := range ds.states {
+		if state.Region != "" && !seen[state.Region] {
+			seen[state.Region] = true
+			regions = append(regions, state.Region)
+		}
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// GetStateCodesInRegion returns the codes of the states of a region, ignoring case and accents
+func (ds *DataStore) GetStateCodesInRegion(region string) []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	key := normalizePlaceName(region)
+	codes := make([]string, 0)
+	for _, state := range ds.states {
+		if normalizePlaceName(state.Region) == key {
+			codes = append(codes, state.Code)
+		}
+	}
+	return codes
+}
+
 // GetRandomProfession returns a random profession
 func (ds *DataStore) GetRandomProfession() ProfessionData {
 	ds.mu.RLock()
@@ -451,6 +915,79 @@ func (ds *DataStore) GetRandomProfession() ProfessionData {
 	return ds.professions[rand.Intn(len(ds.professions))]
 }
 
+// GetProfessions returns all the professions
+func (ds *DataStore) GetProfessions() []ProfessionData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.professions
+}
+
+// GetCouncilProfessions returns the professions regulated by a council
+func (ds *DataStore) GetCouncilProfessions(council string) []ProfessionData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	professions := make([]ProfessionData, 0)
+	for _, profession := range ds.professions {
+		if profession.Council == council {
+			professions = append(professions, profession)
+		}
+	}
+	return professions
+}
+
+// GetSalaryRange returns the salary range of a profession
+// Uses the profession override when available, otherwise the range of its area
+func (ds *DataStore) GetSalaryRange(profession ProfessionData) SalaryRange {
+	if profession.SalaryMin > 0 && profession.SalaryMax > 0 {
+		return SalaryRange{Min: profession.SalaryMin, Max: profession.SalaryMax}
+	}
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	if salaryRange, exists := ds.salaryRanges[profession.Area]; exists {
+		return salaryRange
+	}
+	return SalaryRange{Min: ds.minimumWage, Max: ds.minimumWage * 3}
+}
+
+// GetEducationSalaryFactor returns the salary multiplier of an education level
+func (ds *DataStore) GetEducationSalaryFactor(education string) float64 {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	if factor, exists := ds.educationFactors[education]; exists {
+		return factor
+	}
+	return 1
+}
+
+// GetMinimumWage returns the national minimum wage
+func (ds *DataStore) GetMinimumWage() float64 {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.minimumWage
+}
+
+// GetProfessionAreas returns the sorted list of profession areas
+func (ds *DataStore) GetProfessionAreas() []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	areas := make([]string, 0)
+	for _, profession := range ds.professions {
+		if !seen[profession.Area] {
+			seen[profession.Area] = true
+			areas = append(areas, profession.Area)
+		}
+	}
+	sort.Strings(areas)
+
+	return areas
+}
+
 // GetRandomEducationLevel returns a random education level
 func (ds *DataStore) GetRandomEducationLevel() string {
 	ds.mu.RLock()
@@ -458,6 +995,41 @@ func (ds *DataStore) GetRandomEducationLevel() string {
 	return ds.educationLevels[rand.Intn(len(ds.educationLevels))]
 }
 
+// GetEducationLevels returns all the education levels
+func (ds *DataStore) GetEducationLevels() []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.educationLevels
+}
+
+// GetEducationMinAge returns the minimum age to have reached an education level
+func (ds *DataStore) GetEducationMinAge(education string) int {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.educationMinAges[education]
+}
+
+// GetRandomEducationLevelForAge returns a random education level reachable at the given age
+func (ds *DataStore) GetRandomEducationLevelForAge(age int) string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return pickForAge(ds.educationLevels, ds.educationMinAges, age)
+}
+
+// GetMinorEducationLevel returns the education level in progress of a minor of the given age
+func (ds *DataStore) GetMinorEducationLevel(age int) string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	education := ""
+	for _, level := range ds.minorEducation {
+		if level.MinAge <= age {
+			education = level.Education
+		}
+	}
+	return education
+}
+
 // GetRandomMaritalStatus returns a random marital status
 func (ds *DataStore) GetRandomMaritalStatus() string {
 	ds.mu.RLock()
@@ -465,6 +1037,54 @@ func (ds *DataStore) GetRandomMaritalStatus() string {
 	return ds.maritalStatuses[rand.Intn(len(ds.maritalStatuses))]
 }
 
+// GetMaritalStatuses returns all the marital statuses
+func (ds *DataStore) GetMaritalStatuses() []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.maritalStatuses
+}
+
+// GetMaritalStatusMinAge returns the minimum age to have a marital status
+func (ds *DataStore) GetMaritalStatusMinAge(status string) int {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.maritalMinAges[status]
+}
+
+// GetRandomMaritalStatusForAge returns a random marital status possible at the given age
+func (ds *DataStore) GetRandomMaritalStatusForAge(age int) string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return pickForAge(ds.maritalStatuses, ds.maritalMinAges, age)
+}
+
+// GetBloodTypes returns all the blood types
+func (ds *DataStore) GetBloodTypes() []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.bloodTypes
+}
+
+// pickForAge picks a random value whose minimum age is reached at the given age
+// Falls back to the value with the lowest minimum age
+func pickForAge(values []string, minAges map[string]int, age int) string {
+	candidates := make([]string, 0, len(values))
+	lowest := values[0]
+	for _, value := range values {
+		if minAges[value] <= age {
+			candidates = append(candidates, value)
+		}
+		if minAges[value] < minAges[lowest] {
+			lowest = value
+		}
+	}
+
+	if len(candidates) == 0 {
+		return lowest
+	}
+	return candidates[rand.Intn(len(candidates))]
+}
+
 // loadRealAddresses loads all real addresses from the JSON files of states
 func (ds *DataStore) loadRealAddresses() error {
 	projectRoot, err := findProjectRoot()
@@ -520,17 +1140,43 @@ func (ds *DataStore) loadRealAddresses() error {
 		stateCodeUpper := strings.ToUpper(stateCode)
 		addresses := []RealAddress{}
 		cityNames := []string{}
+		ibgeCode := ""
 
 		// Extract city names and addresses
 		for _, city := range stateData.Cities {
 			cityNames = append(cityNames, city.Name)
+			key := cityKey(stateCodeUpper, city.Name)
+			ds.cityNames[key] = city.Name
+			if city.IBGECode != "" {
+				ds.cityIBGECodes[key] = city.IBGECode
+			}
+			if city.DDD != "" {
+				ds.cityDDDs[key] = city.DDD
+			}
+			if ibgeCode == "" && len(city.IBGECode) >= 2 {
+				ibgeCode = city.IBGECode[:2]
+			}
+			cityDDD := city.DDD
+			if cityDDD == "" {
+				cityDDD = stateData.DDD
+			}
 			for _, district := range city.Districts {
 				for _, street := range district.Streets {
 					totalAddresses++
+					record := ZipcodeRecord{
+						RealAddress:  street,
+						StateCode:    stateCodeUpper,
+						CityIBGECode: city.IBGECode,
+						CityDDD:      cityDDD,
+					}
+					ds.zipcodes[onlyDigits(street.CEP)] = record
+					ds.streets.add(record)
 
 					// Filter only complete addresses (with latitude and longitude)
 					if street.Latitude != "" && street.Longitude != "" {
+						ds.spatial.add(record)
 						addresses = append(addresses, street)
+						ds.cityAddresses[key] = append(ds.cityAddresses[key], street)
 						completeAddresses++
 					}
 				}
@@ -539,13 +1185,17 @@ func (ds *DataStore) loadRealAddresses() error {
 
 		// Fill state data
 		stateInfo := StateData{
-			Code:   stateCodeUpper,
-			Name:   stateData.Name,
-			DDD:    stateData.DDD,
-			Cities: cityNames,
+			Code:     stateCodeUpper,
+			Name:     stateData.Name,
+			DDD:      stateData.DDD,
+			IBGECode: ibgeCode,
+			Region:   stateData.Region,
+			Capital:  stateData.Capital,
+			Cities:   cityNames,
 		}
 
 		ds.states = append(ds.states, stateInfo)
+		ds.stateAddresses[stateCodeUpper] = stateData
 		ds.stateMap[stateCodeUpper] = &ds.states[len(ds.states)-1]
 
 		if len(addresses) > 0 {
@@ -564,6 +1214,8 @@ func (ds *DataStore) loadRealAddresses() error {
 		}
 	}
 
+	ds.streets.sortTokens()
+
 	log.Info().
 		Int("total_addresses", totalAddresses).
 		Int("complete_addresses", completeAddresses).
@@ -600,6 +1252,65 @@ func (ds *DataStore) GetRandomRealAddress(stateCode string) *RealAddress {
 	return &addresses[idx]
 }
 
+// GetRandomRealAddressInCity returns a random real address of a city of the state
+// It returns nil when the city has no complete address
+func (ds *DataStore) GetRandomRealAddressInCity(stateCode, city string) *RealAddress {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	addresses := ds.cityAddresses[cityKey(stateCode, city)]
+	if len(addresses) == 0 {
+		return nil
+	}
+	return &addresses[rand.Intn(len(addresses))]
+}
+
+// GetStateAddressData returns the cities, districts and streets of a state, or nil when the state is unknown
+// The cities are shared with the DataStore and must not be modified
+func (ds *DataStore) GetStateAddressData(stateCode string) *StateAddressData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	data, ok := ds.stateAddresses[strings.ToUpper(stateCode)]
+	if !ok {
+		return nil
+	}
+	return &data
+}
+
+// LookupZipcode returns the real address of a CEP, with or without mask, or nil when the CEP is unknown
+func (ds *DataStore) LookupZipcode(cep string) *ZipcodeRecord {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	record, ok := ds.zipcodes[onlyDigits(cep)]
+	if !ok {
+		return nil
+	}
+	return &record
+}
+
+// FindAddressesWithin returns the real addresses at most radiusKm away from the point, sorted by distance
+// Only addresses with coordinates are indexed
+func (ds *DataStore) FindAddressesWithin(lat, lng, radiusKm float64) []NearbyAddress {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.spatial.within(lat, lng, radiusKm)
+}
+
+// SearchStreets returns up to limit real streets matching the query, best ranked first
+// The state and the city, matched ignoring case and accents, restrict the streets when not empty
+func (ds *DataStore) SearchStreets(query, stateCode, city string, limit int) []ZipcodeRecord {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	key := ""
+	if city != "" {
+		key = cityKey(stateCode, city)
+	}
+	return ds.streets.search(query, strings.ToUpper(stateCode), key, limit)
+}
+
 // loadEmailData loads email data from the JSON file
 func (ds *DataStore) loadEmailData() error {
 	filePath := getDataPath("email.json")
@@ -641,3 +1352,193 @@ func (ds *DataStore) GetRandomEmailExtension() string {
 	defer ds.mu.RUnlock()
 	return ds.emailExtensions[rand.Intn(len(ds.emailExtensions))]
 }
+
+// loadForeignData loads the countries of foreign residents from the JSON file
+func (ds *DataStore) loadForeignData() error {
+	filePath := getDataPath("foreign.json")
+	log.Debug().Str("file", filePath).Msg("Loading foreign data")
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		log.Error().Err(err).Str("file", filePath).Msg("Failed to read foreign data file")
+		return err
+	}
+
+	var data foreignData
+	if err := json.Unmarshal(content, &data); err != nil {
+		log.Error().Err(err).Str("file", filePath).Msg("Failed to parse foreign JSON")
+		return err
+	}
+
+	weights := make([]float64, 0, len(data.Countries))
+	for _, country := range data.Countries {
+		if len(country.FirstNames.Male) == 0 || len(country.FirstNames.Female) == 0 || len(country.LastNames) == 0 || len(country.Cities) == 0 {
+			return fmt.Errorf("country %s has no names or cities", country.Code)
+		}
+		weights = append(weights, country.Weight)
+	}
+
+	ds.countries = data.Countries
+	for i := range ds.countries {
+		ds.countryMap[strings.ToUpper(ds.countries[i].Code)] = &ds.countries[i]
+	}
+	ds.countryTable = newAliasTable(weights)
+
+	log.Info().
+		Int("countries", len(ds.countries)).
+		Msg("Foreign data loaded")
+
+	return nil
+}
+
+// GetCountryByCode returns the country with the given ISO 3166-1 alpha-2 code, or nil if it is unknown
+func (ds *DataStore) GetCountryByCode(code string) *CountryData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	return ds.countryMap[strings.ToUpper(code)]
+}
+
+// GetRandomCountry returns a random country following the share of its residents in Brazil
+func (ds *DataStore) GetRandomCountry() *CountryData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	return &ds.countries[ds.countryTable.pick()]
+}
+
+// GetCountryCodes returns the codes of the available countries
+func (ds *DataStore) GetCountryCodes() []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	codes := make([]string, 0, len(ds.countries))
+	for _, country := range ds.countries {
+		codes = append(codes, country.Code)
+	}
+	return codes
+}
+
+// loadCompanyData loads the economic activities and legal natures of companies from the JSON file
+func (ds *DataStore) loadCompanyData() error {
+	filePath := getDataPath("company.json")
+	log.Debug().Str("file", filePath).Msg("Loading company data")
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		log.Error().Err(err).Str("file", filePath).Msg("Failed to read company data file")
+		return err
+	}
+
+	var data companyData
+	if err := json.Unmarshal(content, &data); err != nil {
+		log.Error().Err(err).Str("file", filePath).Msg("Failed to parse company JSON")
+		return err
+	}
+
+	for _, activity := range data.Activities {
+		if len(activity.Areas) == 0 {
+			return fmt.Errorf("activity %s has no profession areas", activity.Code)
+		}
+	}
+
+	for _, nature := range data.LegalNatures {
+		if nature.MinPartners < 1 || nature.MaxPartners < nature.MinPartners {
+			return fmt.Errorf("legal nature %s %s has an invalid partner count", nature.Code, nature.Suffix)
+		}
+		for _, code := range []string{nature.Administrator, nature.Member} {
+			if _, ok := data.Qualifications[code]; code != "" && !ok {
+				return fmt.Errorf("legal nature %s %s has an unknown qualification %s", nature.Code, nature.Suffix, code)
+			}
+		}
+	}
+
+	ds.activities = data.Activities
+	ds.legalNatures = data.LegalNatures
+	ds.qualifications = data.Qualifications
+
+	log.Info().
+		Int("activities", len(ds.activities)).
+		Int("legal_natures", len(ds.legalNatures)).
+		Msg("Company data loaded")
+
+	return nil
+}
+
+// GetActivities returns all the economic activities
+func (ds *DataStore) GetActivities() []ActivityData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.activities
+}
+
+// GetRandomActivity returns a random economic activity
+func (ds *DataStore) GetRandomActivity() ActivityData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.activities[rand.Intn(len(ds.activities))]
+}
+
+// FindActivities returns the economic activities whose CNAE code starts with the given digits
+// Punctuation is ignored, so 62, 6201 and 6201-5/01 are all accepted
+func (ds *DataStore) FindActivities(code string) []ActivityData {
+	prefix := onlyDigits(code)
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	activities := make([]ActivityData, 0)
+	if prefix == "" {
+		return activities
+	}
+	for _, activity := range ds.activities {
+		if strings.HasPrefix(onlyDigits(activity.Code), prefix) {
+			activities = append(activities, activity)
+		}
+	}
+	return activities
+}
+
+// FindMEIActivities returns the activities allowed for a microempreendedor individual whose CNAE code
+// starts with the given digits, or every allowed activity when the code is empty
+func (ds *DataStore) FindMEIActivities(code string) []ActivityData {
+	if code == "" {
+		return ds.GetMEIActivities()
+	}
+
+	activities := make([]ActivityData, 0)
+	for _, activity := range ds.FindActivities(code) {
+		if activity.MEI {
+			activities = append(activities, activity)
+		}
+	}
+	return activities
+}
+
+// GetMEIActivities returns the economic activities allowed for a microempreendedor individual
+func (ds *DataStore) GetMEIActivities() []ActivityData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	activities := make([]ActivityData, 0)
+	for _, activity := range ds.activities {
+		if activity.MEI {
+			activities = append(activities, activity)
+		}
+	}
+	return activities
+}
+
+// GetLegalNatures returns all the legal natures of companies
+func (ds *DataStore) GetLegalNatures() []LegalNatureData {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.legalNatures
+}
+
+// GetQualification returns the description of a partner qualification code
+func (ds *DataStore) GetQualification(code string) string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.qualifications[code]
+}
@@ -33,18 +33,34 @@ func (g *Generator) generateRandomDomain() string {
 func (g *Generator) generateEmailWithDomain(domain string) (email, username string, returnDomain string) {
 	ds := g.dataStore
 
-	// Generate a random name for the email
+	// Generate a random name of any gender for the email
 	firstName := ds.GetRandomMaleFirstName()
+	if rand.Intn(2) == 0 {
+		firstName = ds.GetRandomFemaleFirstName()
+	}
 	lastName := ds.GetRandomLastName()
 
+	username = buildEmailUsername(firstName, lastName, 0)
+	email = fmt.Sprintf("%s@%s", username, domain)
+
+	return email, username, domain
+}
+
+// buildEmailUsername builds a realistic username from a first name and a last name
+// Uses the first word of the first name and the last word of the last name
+// When birthYear is greater than zero, it is used in the formats that contain a year
+func buildEmailUsername(firstName, lastName string, birthYear int) (username string) {
 	// Clean the names to use as base for the username
 	firstParts := strings.Fields(firstName)
 	lastParts := strings.Fields(lastName)
 
-	cleanFirst := strings.ToLower(firstParts[0])
+	cleanFirst := ""
+	if len(firstParts) > 0 {
+		cleanFirst = strings.ToLower(removeAccents(firstParts[0]))
+	}
 	cleanLast := ""
 	if len(lastParts) > 0 {
-		cleanLast = strings.ToLower(lastParts[0])
+		cleanLast = strings.ToLower(removeAccents(lastParts[len(lastParts)-1]))
 	}
 
 	// Choose one of the most realistic and short formats (logic from person.go)
@@ -69,7 +85,10 @@ func (g *Generator) generateEmailWithDomain(domain string) (email, username stri
 	case 4:
 		// name + birth year (realistic)
 		year := 85 + rand.Intn(35) // 1985-2019
-		username = fmt.Sprintf("%s%d", cleanFirst, year)
+		if birthYear > 0 {
+			year = birthYear % 100
+		}
+		username = fmt.Sprintf("%s%02d", cleanFirst, year)
 	case 5:
 		// abbreviated name + abbreviated lastname
 		if len(cleanFirst) > 3 && len(cleanLast) > 2 {
@@ -99,12 +118,7 @@ func (g *Generator) generateEmailWithDomain(domain string) (email, username stri
 	}
 
 	// Remove accents and sanitize the username
-	username = sanitizeUsername(removeAccents(username))
-
-	// Format the email
-	email = fmt.Sprintf("%s@%s", username, domain)
-
-	return email, username, domain
+	return sanitizeUsername(removeAccents(username))
 }
 
 // removeAccents remove accents from the text
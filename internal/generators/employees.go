@@ -0,0 +1,152 @@
+package generators
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Number of employees of generated rosters
+const (
+	MinCompanyEmployees     = 1
+	MaxCompanyEmployees     = 200
+	DefaultCompanyEmployees = 10
+)
+
+// Share of the employees working in the core areas of the company activity
+const coreAreaEmployeeShare = 70
+
+// Age limit of generated employees
+const maxEmployeeAge = 65
+
+// supportAreas are the profession areas found in companies of any activity
+var supportAreas = []string{"Administrativo", "Financeiro", "Recursos Humanos", "Comercial", "Gestão"}
+
+// GenerateCompanyEmployees generates a company and a roster of its employees
+// The company uses the given CNPJ when it is valid, and a random number of employees is used when count is out of range
+// Employees live in the state of the company, have professions weighted by its main activity, corporate emails on
+// its domain and were admitted after its foundation (and before it became inactive)
+func (g *Generator) GenerateCompanyEmployees(opts CompanyOptions, cnpj string, count int) *models.CompanyEmployeesResponse {
+	if count < MinCompanyEmployees || count > MaxCompanyEmployees {
+		count = DefaultCompanyEmployees
+	}
+
+	company := g.GenerateCompanyWithOptions(opts)
+	if ValidateCNPJ(cnpj) {
+		company.CNPJ = FormatCNPJ(onlyDigits(cnpj))
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	foundedAt, _ := time.Parse("2006-01-02", company.FoundedAt)
+	latest := today
+	if company.Status != CompanyStatusActive {
+		latest, _ = time.Parse("2006-01-02", company.StatusDate)
+	}
+
+	var coreAreas []string
+	if activities := g.dataStore.FindActivities(company.MainActivity.Code); len(activities) > 0 {
+		coreAreas = activities[0].Areas
+	}
+
+	employees := make([]models.CompanyEmployee, 0, count)
+	emails := make(map[string]bool, count)
+	for i := 0; i < count; i++ {
+		area := supportAreas[rand.Intn(len(supportAreas))]
+		if len(coreAreas) > 0 && rand.Intn(100) < coreAreaEmployeeShare {
+			area = coreAreas[rand.Intn(len(coreAreas))]
+		}
+		employees = append(employees, g.generateCompanyEmployee(company, area, foundedAt, latest, today, emails))
+	}
+
+	// Employee IDs are sequential in the order of admission
+	sort.SliceStable(employees, func(i, j int) bool {
+		return employees[i].AdmissionDate < employees[j].AdmissionDate
+	})
+	firstID := 1 + rand.Intn(1000)
+	for i := range employees {
+		employees[i].EmployeeID = fmt.Sprintf("%06d", firstID+i)
+	}
+
+	return &models.CompanyEmployeesResponse{
+		Company:   *company,
+		Employees: employees,
+	}
+}
+
+// generateCompanyEmployee generates an employee of the company working in the area
+// The employee is an adult at the admission date, which lies between the foundation and the latest date
+func (g *Generator) generateCompanyEmployee(company *models.CompanyResponse, area string, foundedAt, latest, today time.Time, emails map[string]bool) models.CompanyEmployee {
+	minAge := AdultAge + today.Year() - latest.Year() + 1
+	hasCompany := false
+	person := g.GeneratePersonWithOptions(PersonOptions{
+		State:          company.Address.State,
+		MinAge:         minAge,
+		MaxAge:         max(minAge, maxEmployeeAge),
+		ProfessionArea: area,
+		HasCompany:     &hasCompany,
+	})
+	person.Company = &models.PersonCompany{Name: company.Name, CNPJ: company.CNPJ}
+
+	birthdate, _ := time.Parse("2006-01-02", person.Birthdate)
+	earliest := foundedAt
+	if adult := birthdate.AddDate(AdultAge, 0, 0); adult.After(earliest) {
+		earliest = adult
+	}
+	admission := earliest.AddDate(0, 0, rand.Intn(max(int(latest.Sub(earliest).Hours()/24), 0)+1))
+
+	// The corporate email follows the name the person is known by
+	displayName := person.Name
+	if person.SocialName != nil {
+		displayName = *person.SocialName
+	}
+
+	return models.CompanyEmployee{
+		CorporateEmail: generateCorporateEmail(displayName, company.Domain, emails),
+		JobTitle:       person.Profession.Title,
+		Department:     person.Profession.Area,
+		AdmissionDate:  admission.Format("2006-01-02"),
+		Salary:         person.Profession.Salary,
+		SalaryValue:    person.Profession.SalaryValue,
+		Person:         *person,
+	}
+}
+
+// generateCorporateEmail generates the corporate email of an employee in the usual firstname.lastname format
+// A number is appended to homonyms so every address in the roster is unique
+func generateCorporateEmail(name models.PersonName, domain string, taken map[string]bool) string {
+	username := corporateEmailPart(name.FirstName)
+	if last := strings.Fields(name.LastName); len(last) > 0 {
+		username += "." + corporateEmailPart(last[len(last)-1])
+	}
+	username = strings.Trim(username, ".")
+	if username == "" {
+		username = "colaborador"
+	}
+
+	email := fmt.Sprintf("%s@%s", username, domain)
+	for n := 2; taken[email]; n++ {
+		email = fmt.Sprintf("%s%d@%s", username, n, domain)
+	}
+	taken[email] = true
+	return email
+}
+
+// corporateEmailPart keeps the letters and digits of the first word of a name, without accents
+func corporateEmailPart(name string) string {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ""
+	}
+
+	var part strings.Builder
+	for _, r := range strings.ToLower(removeAccents(words[0])) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			part.WriteRune(r)
+		}
+	}
+	return part.String()
+}
@@ -0,0 +1,102 @@
+package generators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for employee rosters focusing on:
+// 1. Employees referencing the company CNPJ and name
+// 2. Unique corporate emails on the company domain
+// 3. Job titles weighted by the company activity
+// 4. Admission dates after the foundation and sequential employee IDs
+
+func TestGenerateCompanyEmployees_Company(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	roster := gen.GenerateCompanyEmployees(CompanyOptions{State: "PE"}, "11222333000181", 30)
+
+	assert.Equal(t, "11.222.333/0001-81", roster.Company.CNPJ)
+	assert.Len(t, roster.Employees, 30)
+	emails := make(map[string]bool)
+	for _, employee := range roster.Employees {
+		if assert.NotNil(t, employee.Person.Company) {
+			assert.Equal(t, roster.Company.CNPJ, employee.Person.Company.CNPJ)
+			assert.Equal(t, roster.Company.Name, employee.Person.Company.Name)
+		}
+		assert.Equal(t, "PE", employee.Person.Address.State)
+		assert.True(t, strings.HasSuffix(employee.CorporateEmail, "@"+roster.Company.Domain), employee.CorporateEmail)
+		assert.False(t, emails[employee.CorporateEmail], "Corporate emails should be unique")
+		emails[employee.CorporateEmail] = true
+	}
+}
+
+func TestGenerateCompanyEmployees_GeneratedCompany(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	roster := gen.GenerateCompanyEmployees(CompanyOptions{}, "", 0)
+
+	assert.True(t, ValidateCNPJ(roster.Company.CNPJ))
+	assert.Len(t, roster.Employees, DefaultCompanyEmployees)
+}
+
+func TestGenerateCompanyEmployees_JobTitlesFollowActivity(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	roster := gen.GenerateCompanyEmployees(CompanyOptions{Activity: "6201-5/01"}, "", MaxCompanyEmployees)
+
+	activity := gen.dataStore.FindActivities("6201-5/01")[0]
+	core := 0
+	for _, employee := range roster.Employees {
+		assert.Equal(t, employee.Person.Profession.Title, employee.JobTitle)
+		assert.Equal(t, employee.Person.Profession.SalaryValue, employee.SalaryValue)
+		assert.Greater(t, employee.SalaryValue, 0.0)
+		for _, area := range activity.Areas {
+			if employee.Department == area {
+				core++
+			}
+		}
+	}
+	assert.Greater(t, core, MaxCompanyEmployees/2, "Most employees should work in the core areas of the activity")
+}
+
+func TestGenerateCompanyEmployees_AdmissionDates(t *testing.T) {
+	gen := newTestGenerator(t)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for i := 0; i < 10; i++ {
+		roster := gen.GenerateCompanyEmployees(CompanyOptions{}, "", 20)
+		foundedAt, _ := time.Parse("2006-01-02", roster.Company.FoundedAt)
+		latest := today
+		if roster.Company.Status != CompanyStatusActive {
+			latest, _ = time.Parse("2006-01-02", roster.Company.StatusDate)
+		}
+
+		previousID := ""
+		for _, employee := range roster.Employees {
+			admission, err := time.Parse("2006-01-02", employee.AdmissionDate)
+			assert.NoError(t, err)
+			assert.False(t, admission.Before(foundedAt), "Admission should follow the foundation")
+			assert.False(t, admission.After(latest), "Admission should precede the end of the activities")
+
+			birthdate, _ := time.Parse("2006-01-02", employee.Person.Birthdate)
+			assert.False(t, admission.Before(birthdate.AddDate(AdultAge, 0, 0)), "Employees should be adults at the admission")
+
+			assert.Greater(t, employee.EmployeeID, previousID, "Employee IDs should follow the admission order")
+			previousID = employee.EmployeeID
+		}
+	}
+}
+
+func TestGenerateCorporateEmail(t *testing.T) {
+	taken := make(map[string]bool)
+	name := models.PersonName{FirstName: "José Antônio", LastName: "D'Ávila Conceição", FullName: "José Antônio D'Ávila Conceição"}
+
+	assert.Equal(t, "jose.conceicao@empresa.com.br", generateCorporateEmail(name, "empresa.com.br", taken))
+	assert.Equal(t, "jose.conceicao2@empresa.com.br", generateCorporateEmail(name, "empresa.com.br", taken))
+	assert.Equal(t, "colaborador@empresa.com", generateCorporateEmail(models.PersonName{}, "empresa.com", taken))
+}
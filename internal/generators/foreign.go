@@ -0,0 +1,204 @@
+package generators
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Nationalities accepted by PersonOptions, besides the code of a country of foreign.json
+const (
+	// NationalityBrazilian is the nationality of Brazilian nationals
+	NationalityBrazilian = "BR"
+	// NationalityForeign picks a country following the share of its residents in Brazil
+	NationalityForeign = "foreign"
+)
+
+// foreignBirthState is the state code used for persons born abroad (exterior)
+const foreignBirthState = "EX"
+
+// Surname conventions of the countries of foreign residents
+const (
+	// surnameStyleHispanic puts the paternal surname before the maternal one
+	surnameStyleHispanic = "hispanic"
+	// surnameStylePortuguese puts the maternal surname before the paternal one, as in Brazil
+	surnameStylePortuguese = "portuguese"
+	// surnameStyleSingle passes only the paternal surname
+	surnameStyleSingle = "single"
+)
+
+// crnmStartDate is the date the Migration Law (Lei 13.445/2017) replaced the RNE by the CRNM
+var crnmStartDate = time.Date(2017, 11, 21, 0, 0, 0, 0, time.UTC)
+
+// Validity of the migration registry card by residence type
+const (
+	permanentResidenceYears = 9
+	temporaryResidenceYears = 2
+	passportValidityYears   = 10
+	// maxResidenceYears limits how long ago a foreign resident arrived
+	maxResidenceYears = 30
+)
+
+// rnmLetters are the letters starting migration registry numbers
+var rnmLetters = []string{"V", "G", "F"}
+
+// generateForeignName generates the name of a foreign national and the names of their parents
+// following the surname convention of the country
+func generateForeignName(country *CountryData, gender string) (name, father, mother models.PersonName) {
+	firstNames := country.FirstNames.Female
+	if gender == GenderMale {
+		firstNames = country.FirstNames.Male
+	}
+	pickFirst := func(names []string) string { return names[rand.Intn(len(names))] }
+	pickLast := func() string { return country.LastNames[rand.Intn(len(country.LastNames))] }
+
+	personFirstNames := []string{pickFirst(firstNames)}
+	if country.SurnameStyle != surnameStyleSingle && rand.Intn(100) < 20 {
+		if second := pickFirst(firstNames); second != personFirstNames[0] {
+			personFirstNames = append(personFirstNames, second)
+		}
+	}
+
+	paternal, maternal := pickLast(), pickLast()
+	fatherFirst := []string{pickFirst(country.FirstNames.Male)}
+	motherFirst := []string{pickFirst(country.FirstNames.Female)}
+
+	switch country.SurnameStyle {
+	case surnameStyleHispanic:
+		name = composePersonName(personFirstNames, []string{paternal, maternal})
+		father = composePersonName(fatherFirst, []string{paternal, pickLast()})
+		mother = composePersonName(motherFirst, []string{maternal, pickLast()})
+	case surnameStylePortuguese:
+		name = composePersonName(personFirstNames, []string{maternal, paternal})
+		father = composePersonName(fatherFirst, []string{pickLast(), paternal})
+		mother = composePersonName(motherFirst, []string{pickLast(), maternal})
+	default:
+		name = composePersonName(personFirstNames, []string{paternal})
+		father = composePersonName(fatherFirst, []string{paternal})
+		mother = composePersonName(motherFirst, []string{maternal})
+	}
+
+	return name, father, mother
+}
+
+// generateForeignResident generates the migration data of a foreign national born at the given date
+// The entry date is within the last 30 years, and cards are renewed until the current one is valid
+func generateForeignResident(country *CountryData, birthdate string) *models.PersonForeignResident {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	birth, err := time.Parse("2006-01-02", birthdate)
+	if err != nil {
+		birth = today.AddDate(-DefaultMinAge, 0, 0)
+	}
+
+	earliest := today.AddDate(-maxResidenceYears, 0, 0)
+	if birth.After(earliest) {
+		earliest = birth
+	}
+	latest := today.AddDate(0, 0, -30)
+	entry := earliest.AddDate(0, 0, rand.Intn(max(int(latest.Sub(earliest).Hours()/24), 1)))
+
+	return &models.PersonForeignResident{
+		Nationality:    country.Nationality,
+		CountryOfBirth: country.Name,
+		EntryDate:      entry.Format("2006-01-02"),
+		RNM:            generatePersonRNM(entry, today),
+		Passport:       generatePersonPassport(country, birth, today),
+	}
+}
+
+// generatePersonRNM generates the migration registry card of a resident who arrived at the given date
+func generatePersonRNM(entry, today time.Time) models.PersonRNM {
+	residenceType, validity := "permanent", permanentResidenceYears
+	if rand.Intn(100) < 30 {
+		residenceType, validity = "temporary", temporaryResidenceYears
+	}
+
+	// First card issued up to 6 months after the entry, then renewed when it expires
+	issue := entry.AddDate(0, 0, 30+rand.Intn(151))
+	if issue.After(today) {
+		issue = today
+	}
+	expiration := issue.AddDate(validity, 0, 0)
+	for expiration.Before(today) {
+		issue = expiration
+		expiration = issue.AddDate(validity, 0, 0)
+	}
+
+	documentType := "CRNM"
+	if issue.Before(crnmStartDate) {
+		documentType = "RNE"
+	}
+
+	unmasked := generateRNMNumber()
+
+	return models.PersonRNM{
+		Masked:         FormatRNM(unmasked),
+		Unmasked:       unmasked,
+		DocumentType:   documentType,
+		ResidenceType:  residenceType,
+		IssueDate:      issue.Format("2006-01-02"),
+		ExpirationDate: expiration.Format("2006-01-02"),
+	}
+}
+
+// generateRNMNumber generates a migration registry number: a letter, 6 digits and a check digit
+func generateRNMNumber() string {
+	digits := fmt.Sprintf("%06d", rand.Intn(1000000))
+	return rnmLetters[rand.Intn(len(rnmLetters))] + digits + calculateRNMCheckDigit(digits)
+}
+
+// calculateRNMCheckDigit calculates the check digit of the 6 digits of a migration registry number
+// Modulo 11 with weights from 2 to 7, a remainder of 10 becomes 0
+func calculateRNMCheckDigit(digits string) string {
+	sum := 0
+	for i, digit := range digits {
+		sum += int(digit-'0') * (i + 2)
+	}
+	return fmt.Sprintf("%d", sum%11%10)
+}
+
+// FormatRNM formats a migration registry number (ex: V123456-7)
+func FormatRNM(rnm string) string {
+	if len(rnm) != 8 {
+		return rnm
+	}
+	return rnm[:7] + "-" + rnm[7:]
+}
+
+// generatePersonPassport generates a valid passport of the country for a person born at the given date
+func generatePersonPassport(country *CountryData, birth, today time.Time) models.PersonPassport {
+	earliest := today.AddDate(-(passportValidityYears - 1), 0, 0)
+	if birth.After(earliest) {
+		earliest = birth
+	}
+	issue := earliest.AddDate(0, 0, rand.Intn(max(int(today.Sub(earliest).Hours()/24), 1)))
+
+	return models.PersonPassport{
+		Number:         generatePassportNumber(country.PassportFormat),
+		Country:        country.Code,
+		IssueDate:      issue.Format("2006-01-02"),
+		ExpirationDate: issue.AddDate(passportValidityYears, 0, 0).Format("2006-01-02"),
+	}
+}
+
+// generatePassportNumber generates a passport number following a format
+// where A is a random letter, # is a random digit and other characters are kept
+func generatePassportNumber(format string) string {
+	const letters = "ABCDEFGHIJKLMNPRSTUVWXYZ"
+
+	var number strings.Builder
+	for _, r := range format {
+		switch r {
+		case 'A':
+			number.WriteByte(letters[rand.Intn(len(letters))])
+		case '#':
+			number.WriteByte(byte('0' + rand.Intn(10)))
+		default:
+			number.WriteRune(r)
+		}
+	}
+	return number.String()
+}
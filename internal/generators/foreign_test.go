@@ -0,0 +1,128 @@
+package generators
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for foreign residents focusing on:
+// 1. Names following the surname convention of the country
+// 2. Migration registry number (RNE/CRNM) and its dates
+// 3. Passport numbers following the country format
+// 4. Foreign persons with CPF, Brazilian address and no RG
+// 5. Normalization of the nationality
+
+func TestGenerateForeignName_SurnameStyles(t *testing.T) {
+	gen := newTestGenerator(t)
+	ds := gen.GetDataStore()
+
+	for i := 0; i < 50; i++ {
+		name, father, mother := generateForeignName(ds.GetCountryByCode("VE"), GenderFemale)
+		lastNames := strings.Fields(name.LastName)
+		assert.Len(t, lastNames, 2)
+		assert.True(t, strings.HasPrefix(father.LastName, lastNames[0]), "Hispanic names start with the paternal surname")
+		assert.True(t, strings.HasPrefix(mother.LastName, lastNames[1]), "Hispanic names end with the maternal surname")
+		assert.Contains(t, ds.GetCountryByCode("VE").FirstNames.Female, strings.Fields(name.FirstName)[0])
+
+		name, father, _ = generateForeignName(ds.GetCountryByCode("PT"), GenderMale)
+		lastNames = strings.Fields(name.LastName)
+		assert.True(t, strings.HasSuffix(father.LastName, lastNames[len(lastNames)-1]), "Portuguese names end with the paternal surname")
+
+		name, father, _ = generateForeignName(ds.GetCountryByCode("JP"), GenderMale)
+		assert.Equal(t, father.LastName, name.LastName, "Single surname comes from the father")
+	}
+}
+
+func TestGenerateForeignResident(t *testing.T) {
+	gen := newTestGenerator(t)
+	country := gen.GetDataStore().GetCountryByCode("HT")
+	today := time.Now().Format("2006-01-02")
+	rnm := regexp.MustCompile(`^[VGF]\d{6}-\d$`)
+
+	for i := 0; i < 100; i++ {
+		resident := generateForeignResident(country, "1985-04-10")
+
+		assert.Equal(t, "Haiti", resident.CountryOfBirth)
+		assert.Equal(t, "haitiana", resident.Nationality)
+		assert.Greater(t, resident.EntryDate, "1985-04-10")
+		assert.Less(t, resident.EntryDate, today)
+
+		assert.Regexp(t, rnm, resident.RNM.Masked)
+		assert.Equal(t, calculateRNMCheckDigit(resident.RNM.Unmasked[1:7]), resident.RNM.Unmasked[7:])
+		assert.GreaterOrEqual(t, resident.RNM.IssueDate, resident.EntryDate)
+		assert.GreaterOrEqual(t, resident.RNM.ExpirationDate, today, "The current card should be valid")
+		if resident.RNM.IssueDate < crnmStartDate.Format("2006-01-02") {
+			assert.Equal(t, "RNE", resident.RNM.DocumentType)
+		} else {
+			assert.Equal(t, "CRNM", resident.RNM.DocumentType)
+		}
+
+		assert.Regexp(t, `^[A-Z]{2}\d{7}$`, resident.Passport.Number)
+		assert.Equal(t, "HT", resident.Passport.Country)
+		assert.GreaterOrEqual(t, resident.Passport.ExpirationDate, today, "The passport should be valid")
+	}
+}
+
+func TestGeneratePassportNumber(t *testing.T) {
+	assert.Regexp(t, `^E\d{8}$`, generatePassportNumber("E########"))
+	assert.Regexp(t, `^\d{2}[A-Z]{2}\d{5}$`, generatePassportNumber("##AA#####"))
+	assert.Regexp(t, `^[A-Z]{3}\d{6}$`, generatePassportNumber("AAA######"))
+}
+
+func TestFormatRNM(t *testing.T) {
+	assert.Equal(t, "V123456-7", FormatRNM("V1234567"))
+	assert.Equal(t, "123", FormatRNM("123"), "Invalid lengths are returned unchanged")
+}
+
+func TestGeneratePerson_ForeignResident(t *testing.T) {
+	gen := newTestGenerator(t)
+	countries := map[string]bool{}
+
+	for i := 0; i < 100; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{Nationality: NationalityForeign, State: "SP"})
+		country := gen.GetDataStore().GetCountryByCode(person.Nationality)
+		countries[person.Nationality] = true
+
+		if !assert.NotNil(t, country) || !assert.NotNil(t, person.ForeignResident) {
+			continue
+		}
+		assert.NotEqual(t, NationalityBrazilian, person.Nationality)
+		assert.Equal(t, country.Name, person.ForeignResident.CountryOfBirth)
+		assert.Equal(t, "EX", person.BirthState)
+		assert.Contains(t, country.Cities, person.BirthCity)
+		assert.Nil(t, person.RG, "Foreigners have an RNM instead of an RG")
+		assert.True(t, ValidateCPF(person.CPF.Unmasked), "Foreigners also get a CPF")
+		assert.Equal(t, "SP", person.Address.State)
+		assert.GreaterOrEqual(t, person.Age, AdultAge)
+		assert.GreaterOrEqual(t, person.ForeignResident.EntryDate, person.Birthdate)
+	}
+	assert.Greater(t, len(countries), 3, "Random foreign nationalities should vary")
+
+	person := gen.GeneratePersonWithOptions(PersonOptions{})
+	assert.Equal(t, NationalityBrazilian, person.Nationality)
+	assert.Nil(t, person.ForeignResident)
+}
+
+func TestNormalizePersonOptions_Nationality(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"br", NationalityBrazilian},
+		{"FOREIGN", NationalityForeign},
+		{"ve", "VE"},
+	}
+
+	for _, tt := range tests {
+		opts, err := gen.NormalizePersonOptions(PersonOptions{Nationality: tt.input})
+
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, opts.Nationality)
+	}
+}
@@ -0,0 +1,109 @@
+package generators
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Genders of generated persons
+const (
+	GenderMale      = "male"
+	GenderFemale    = "female"
+	GenderNonBinary = "non-binary"
+)
+
+// pronounsByGender contains the usual Portuguese pronouns of each gender
+var pronounsByGender = map[string]string{
+	GenderMale:      "ele/dele",
+	GenderFemale:    "ela/dela",
+	GenderNonBinary: "elu/delu",
+}
+
+// Share of the diverse persons who are trans men or women, the others are non-binary
+const transGenderShare = 60
+
+// genderIdentity contains the gender of a person and the sex registered at their birth
+type genderIdentity struct {
+	gender     string
+	sexAtBirth string
+}
+
+// diverse reports whether the gender differs from the sex at birth
+func (i genderIdentity) diverse() bool {
+	return i.gender != i.sexAtBirth
+}
+
+// pronouns returns the pronouns of the gender
+func (i genderIdentity) pronouns() string {
+	return pronounsByGender[i.gender]
+}
+
+// IsValidGender reports whether the value is one of the supported genders
+func IsValidGender(gender string) bool {
+	_, ok := pronounsByGender[gender]
+	return ok
+}
+
+// generateGenderIdentity picks the gender and the sex at birth of a person
+// A requested gender is always kept; with the given rate, the sex at birth differs from the gender
+// (trans and non-binary persons), otherwise both match
+func generateGenderIdentity(gender string, diversityRate float64) genderIdentity {
+	sexes := []string{GenderMale, GenderFemale}
+	diverse := rand.Float64() < diversityRate
+
+	switch gender {
+	case GenderNonBinary:
+		return genderIdentity{gender: gender, sexAtBirth: sexes[rand.Intn(len(sexes))]}
+	case GenderMale, GenderFemale:
+		if diverse {
+			return genderIdentity{gender: gender, sexAtBirth: oppositeSex(gender)}
+		}
+		return genderIdentity{gender: gender, sexAtBirth: gender}
+	}
+
+	sexAtBirth := sexes[rand.Intn(len(sexes))]
+	switch {
+	case !diverse:
+		return genderIdentity{gender: sexAtBirth, sexAtBirth: sexAtBirth}
+	case rand.Intn(100) < transGenderShare:
+		return genderIdentity{gender: oppositeSex(sexAtBirth), sexAtBirth: sexAtBirth}
+	default:
+		return genderIdentity{gender: GenderNonBinary, sexAtBirth: sexAtBirth}
+	}
+}
+
+// oppositeSex returns female for male and male for female
+func oppositeSex(sex string) string {
+	if sex == GenderMale {
+		return GenderFemale
+	}
+	return GenderMale
+}
+
+// generateSocialName generates the social name (nome social) of a person whose gender differs from the sex at birth
+// The first names follow the gender (unisex names for non-binary persons) and the last names are kept
+func (g *Generator) generateSocialName(identity genderIdentity, civilName models.PersonName, profile nameProfile) *models.PersonName {
+	if !identity.diverse() {
+		return nil
+	}
+
+	var firstNames []string
+	if identity.gender == GenderNonBinary {
+		firstNames = []string{g.dataStore.GetRandomNeutralFirstName()}
+	} else {
+		firstNames = g.generateFirstNames(identity.gender, profile)
+	}
+
+	socialName := composePersonName(firstNames, strings.Fields(civilName.LastName))
+	return &socialName
+}
+
+// preferredName returns the social name of the person when present, the civil name otherwise
+func preferredName(person *models.Person) models.PersonName {
+	if person.SocialName != nil {
+		return *person.SocialName
+	}
+	return person.Name
+}
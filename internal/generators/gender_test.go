@@ -0,0 +1,100 @@
+package generators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for gender identities focusing on:
+// 1. Requested genders are kept
+// 2. Diversity rate controlling the share of trans and non-binary persons
+// 3. Social names and pronouns
+// 4. Normalization of the gender constraints
+
+func TestGenerateGenderIdentity_RequestedGender(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		identity := generateGenderIdentity(GenderFemale, 0.5)
+		assert.Equal(t, GenderFemale, identity.gender)
+
+		identity = generateGenderIdentity(GenderNonBinary, 0)
+		assert.Equal(t, GenderNonBinary, identity.gender)
+		assert.Contains(t, []string{GenderMale, GenderFemale}, identity.sexAtBirth)
+		assert.True(t, identity.diverse())
+	}
+}
+
+func TestGenerateGenderIdentity_DiversityRate(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		identity := generateGenderIdentity("", 0)
+		assert.False(t, identity.diverse(), "No diverse persons with a zero rate")
+
+		identity = generateGenderIdentity("", 1)
+		assert.True(t, identity.diverse(), "Every person is diverse with a rate of 1")
+	}
+
+	diverse := 0
+	const runs = 5000
+	for i := 0; i < runs; i++ {
+		if generateGenderIdentity("", 0.2).diverse() {
+			diverse++
+		}
+	}
+	assert.InDelta(t, 0.2, float64(diverse)/runs, 0.03)
+}
+
+func TestGeneratePerson_SocialName(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{DiversityRate: 1})
+
+		assert.NotEqual(t, person.SexAtBirth, person.Gender)
+		assert.Equal(t, pronounsByGender[person.Gender], person.Pronouns)
+		if assert.NotNil(t, person.SocialName) {
+			assert.Equal(t, person.Name.LastName, person.SocialName.LastName, "Social name keeps the last names")
+		}
+	}
+
+	for i := 0; i < 50; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{})
+
+		assert.Equal(t, person.SexAtBirth, person.Gender)
+		assert.Nil(t, person.SocialName)
+		assert.Contains(t, []string{"ele/dele", "ela/dela"}, person.Pronouns)
+	}
+}
+
+func TestGeneratePerson_SocialNameInPersona(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	person := gen.GeneratePersonWithOptions(PersonOptions{Gender: GenderNonBinary, Persona: true})
+
+	if assert.NotNil(t, person.SocialName) {
+		assert.Equal(t, EmbossedName(*person.SocialName), person.CreditCard.HolderName)
+		first, _ := handleNameParts(*person.SocialName)
+		assert.True(t, strings.Contains(person.Social.Username, first), "Handles should use the social name")
+	}
+	assert.Equal(t, person.Name.FullName, person.BankAccount.HolderName, "Bank account keeps the civil name")
+}
+
+func TestGeneratePerson_MinorsKeepSexAtBirth(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 30; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{MinAge: 0, MaxAge: 17, DiversityRate: 1})
+
+		assert.Equal(t, person.SexAtBirth, person.Gender)
+		assert.Nil(t, person.SocialName)
+	}
+}
+
+func TestNormalizePersonOptions_Gender(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	opts, err := gen.NormalizePersonOptions(PersonOptions{Gender: GenderNonBinary, MinAge: 10, MaxAge: 30})
+	assert.NoError(t, err)
+	assert.Equal(t, AdultAge, opts.MinAge, "Non-binary persons are adults")
+	assert.Equal(t, 30, opts.MaxAge)
+}
@@ -0,0 +1,233 @@
+package generators
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Page sizes of the street listing
+const (
+	DefaultStreetPageSize = 50
+	MaxStreetPageSize     = 200
+)
+
+// Limits of the spatial queries
+const (
+	DefaultNearbyRadiusKm = 5
+	MaxNearbyRadiusKm     = 50
+	DefaultNearbyQuantity = 10
+	// MaxReverseDistanceKm is the farthest a street can be from the point in reverse geocoding
+	MaxReverseDistanceKm = 50
+)
+
+// ListStates returns the states covered by the address database, sorted by code
+func (g *Generator) ListStates() []models.StateResponse {
+	ds := g.dataStore
+
+	states := make([]models.StateResponse, 0)
+	for _, state := range ds.GetStates() {
+		states = append(states, models.StateResponse{
+			Code:     state.Code,
+			Name:     state.Name,
+			IBGECode: state.IBGECode,
+			Region:   state.Region,
+			DDD:      state.DDD,
+			Cities:   len(state.Cities),
+		})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Code < states[j].Code })
+
+	return states
+}
+
+// ListCities returns the cities of a state sorted by name, and false when the state is unknown
+func (g *Generator) ListCities(stateCode string) ([]models.CityResponse, bool) {
+	data := g.dataStore.GetStateAddressData(stateCode)
+	if data == nil {
+		return nil, false
+	}
+
+	cities := make([]models.CityResponse, 0, len(data.Cities))
+	for _, city := range data.Cities {
+		streets := 0
+		for _, district := range city.Districts {
+			streets += len(district.Streets)
+		}
+		ddd := city.DDD
+		if ddd == "" {
+			ddd = data.DDD
+		}
+		cities = append(cities, models.CityResponse{
+			Name:      city.Name,
+			IBGECode:  city.IBGECode,
+			DDD:       ddd,
+			State:     strings.ToUpper(stateCode),
+			Districts: len(city.Districts),
+			Streets:   streets,
+		})
+	}
+	sort.Slice(cities, func(i, j int) bool {
+		return normalizePlaceName(cities[i].Name) < normalizePlaceName(cities[j].Name)
+	})
+
+	return cities, true
+}
+
+// ListDistricts returns the districts of a city sorted by name, and false when the city is unknown in the state
+// The city is matched ignoring case and accents
+func (g *Generator) ListDistricts(stateCode, cityName string) ([]models.DistrictResponse, bool) {
+	city := g.findCityData(stateCode, cityName)
+	if city == nil {
+		return nil, false
+	}
+
+	districts := make([]models.DistrictResponse, 0, len(city.Districts))
+	for _, district := range city.Districts {
+		districts = append(districts, models.DistrictResponse{
+			Name:    district.Name,
+			City:    city.Name,
+			State:   strings.ToUpper(stateCode),
+			Streets: len(district.Streets),
+		})
+	}
+	sort.Slice(districts, func(i, j int) bool {
+		return normalizePlaceName(districts[i].Name) < normalizePlaceName(districts[j].Name)
+	})
+
+	return districts, true
+}
+
+// ListStreets returns a page of the streets of a city sorted by name, optionally restricted to a district
+// It returns false when the city is unknown in the state or the district is unknown in the city; pages after
+// the last one are empty, and the page size falls back to the default when out of range
+func (g *Generator) ListStreets(stateCode, cityName, districtName string, page, pageSize int) (*models.StreetPage, bool) {
+	city := g.findCityData(stateCode, cityName)
+	if city == nil {
+		return nil, false
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > MaxStreetPageSize {
+		pageSize = DefaultStreetPageSize
+	}
+
+	districtKey := normalizePlaceName(districtName)
+	districtFound := districtKey == ""
+	streets := make([]models.StreetResponse, 0)
+	for _, district := range city.Districts {
+		if districtKey != "" && normalizePlaceName(district.Name) != districtKey {
+			continue
+		}
+		districtFound = true
+		for _, street := range district.Streets {
+			streets = append(streets, streetResponse(street, strings.ToUpper(stateCode)))
+		}
+	}
+	if !districtFound {
+		return nil, false
+	}
+	sort.SliceStable(streets, func(i, j int) bool {
+		return normalizePlaceName(streets[i].Street) < normalizePlaceName(streets[j].Street)
+	})
+
+	total := len(streets)
+	start := min((page-1)*pageSize, total)
+	end := min(start+pageSize, total)
+
+	return &models.StreetPage{
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: (total + pageSize - 1) / pageSize,
+		Streets:    streets[start:end],
+	}, true
+}
+
+// findCityData finds the data of a city of the state, ignoring case and accents
+func (g *Generator) findCityData(stateCode, cityName string) *CityData {
+	data := g.dataStore.GetStateAddressData(stateCode)
+	if data == nil {
+		return nil
+	}
+
+	key := normalizePlaceName(cityName)
+	for i := range data.Cities {
+		if normalizePlaceName(data.Cities[i].Name) == key {
+			return &data.Cities[i]
+		}
+	}
+	return nil
+}
+
+// streetResponse converts a real address of the database to a street of the listing
+func streetResponse(street RealAddress, stateCode string) models.StreetResponse {
+	response := models.StreetResponse{
+		Street:       fullStreetName(street),
+		AddressType:  strings.TrimSpace(street.AddressType),
+		Zipcode:      formatCEP(street.CEP),
+		Neighborhood: strings.TrimSpace(street.District),
+		City:         street.City,
+		State:        stateCode,
+	}
+	if street.Latitude != "" && street.Longitude != "" {
+		lat, lng := parseCoordinates(street.Latitude, street.Longitude)
+		response.Coordinates = &models.Coordinates{Lat: lat, Lng: lng}
+	}
+	return response
+}
+
+// FindNearbyAddresses picks up to quantity random real streets within the radius of the point, sorted by distance
+// Total counts every street within the radius; the radius and quantity fall back to the defaults when out of range
+func (g *Generator) FindNearbyAddresses(lat, lng, radiusKm float64, quantity int) *models.NearbyAddressesResponse {
+	if !(radiusKm > 0 && radiusKm <= MaxNearbyRadiusKm) {
+		radiusKm = DefaultNearbyRadiusKm
+	}
+	if quantity < 1 {
+		quantity = DefaultNearbyQuantity
+	}
+
+	found := g.dataStore.FindAddressesWithin(lat, lng, radiusKm)
+	picked := make([]NearbyAddress, 0, min(quantity, len(found)))
+	for _, i := range rand.Perm(len(found))[:min(quantity, len(found))] {
+		picked = append(picked, found[i])
+	}
+	sort.Slice(picked, func(i, j int) bool { return picked[i].DistanceKm < picked[j].DistanceKm })
+
+	addresses := make([]models.NearbyAddressResponse, 0, len(picked))
+	for _, address := range picked {
+		addresses = append(addresses, nearbyAddressResponse(address))
+	}
+
+	return &models.NearbyAddressesResponse{
+		Lat:       lat,
+		Lng:       lng,
+		RadiusKm:  radiusKm,
+		Total:     len(found),
+		Addresses: addresses,
+	}
+}
+
+// ReverseGeocode returns the real street closest to the point, or nil when none is within MaxReverseDistanceKm
+func (g *Generator) ReverseGeocode(lat, lng float64) *models.NearbyAddressResponse {
+	found := g.dataStore.FindAddressesWithin(lat, lng, MaxReverseDistanceKm)
+	if len(found) == 0 {
+		return nil
+	}
+
+	response := nearbyAddressResponse(found[0])
+	return &response
+}
+
+// nearbyAddressResponse converts a real address found near a point, with the distance rounded to meters
+func nearbyAddressResponse(address NearbyAddress) models.NearbyAddressResponse {
+	return models.NearbyAddressResponse{
+		StreetResponse: streetResponse(address.RealAddress, address.StateCode),
+		IBGECode:       address.CityIBGECode,
+		DistanceKm:     math.Round(address.DistanceKm*1000) / 1000,
+	}
+}
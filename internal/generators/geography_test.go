@@ -0,0 +1,106 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for the geographic listings focusing on:
+// 1. States, cities and districts covered by the address database
+// 2. Accent and case insensitive lookup of cities and districts
+// 3. Pagination of the streets
+
+func TestListStates(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	states := gen.ListStates()
+
+	assert.Len(t, states, 27)
+	for i, state := range states {
+		if i > 0 {
+			assert.Less(t, states[i-1].Code, state.Code, "States should be sorted by code")
+		}
+		assert.Len(t, state.IBGECode, 2)
+		assert.NotEmpty(t, state.Region)
+		assert.Greater(t, state.Cities, 0)
+	}
+}
+
+func TestListCities(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	cities, ok := gen.ListCities("ac")
+	assert.True(t, ok)
+	if assert.Len(t, cities, 1) {
+		assert.Equal(t, "Rio Branco", cities[0].Name)
+		assert.Equal(t, "1200401", cities[0].IBGECode)
+		assert.Equal(t, "68", cities[0].DDD)
+		assert.Equal(t, "AC", cities[0].State)
+		assert.Equal(t, 3, cities[0].Districts)
+		assert.Equal(t, 24, cities[0].Streets)
+	}
+
+	_, ok = gen.ListCities("XX")
+	assert.False(t, ok)
+}
+
+func TestListDistricts(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	districts, ok := gen.ListDistricts("MA", "sao luis")
+	assert.True(t, ok)
+	assert.NotEmpty(t, districts)
+	for i, district := range districts {
+		assert.Equal(t, "São Luís", district.City)
+		assert.Equal(t, "MA", district.State)
+		if i > 0 {
+			assert.LessOrEqual(t, normalizePlaceName(districts[i-1].Name), normalizePlaceName(district.Name))
+		}
+	}
+
+	_, ok = gen.ListDistricts("SP", "São Luís")
+	assert.False(t, ok, "São Luís is not in SP")
+}
+
+func TestListStreets_Pagination(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	first, ok := gen.ListStreets("AC", "RIO BRANCO", "", 1, 10)
+	assert.True(t, ok)
+	assert.Equal(t, 24, first.Total)
+	assert.Equal(t, 3, first.TotalPages)
+	assert.Len(t, first.Streets, 10)
+
+	last, ok := gen.ListStreets("AC", "Rio Branco", "", 3, 10)
+	assert.True(t, ok)
+	assert.Len(t, last.Streets, 4)
+	assert.Less(t, normalizePlaceName(first.Streets[9].Street), normalizePlaceName(last.Streets[0].Street), "Pages should follow the alphabetical order")
+
+	beyond, ok := gen.ListStreets("AC", "Rio Branco", "", 4, 10)
+	assert.True(t, ok)
+	assert.Empty(t, beyond.Streets)
+
+	defaults, ok := gen.ListStreets("AC", "Rio Branco", "", 0, 0)
+	assert.True(t, ok)
+	assert.Equal(t, 1, defaults.Page)
+	assert.Equal(t, DefaultStreetPageSize, defaults.PageSize)
+}
+
+func TestListStreets_District(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	page, ok := gen.ListStreets("AC", "Rio Branco", "abraao alab", 1, 50)
+	assert.True(t, ok)
+	assert.Equal(t, 8, page.Total)
+	for _, street := range page.Streets {
+		assert.Equal(t, "Abraão Alab", street.Neighborhood)
+		assert.Equal(t, "Rio Branco", street.City)
+		assert.Equal(t, "AC", street.State)
+		assert.Regexp(t, `^\d{5}-\d{3}$`, street.Zipcode)
+		assert.NotNil(t, street.Coordinates)
+	}
+
+	_, ok = gen.ListStreets("AC", "Rio Branco", "Bairro Inexistente", 1, 50)
+	assert.False(t, ok)
+}
@@ -0,0 +1,234 @@
+package generators
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// HouseholdOptions contains the parameters of a household generation
+type HouseholdOptions struct {
+	State string
+	// Children is the number of children, a negative value means random (0 to 3)
+	Children int
+	// WithParents adds the parents of the head of the household as members
+	WithParents bool
+	// Distribution selects how names are sampled, realistic when empty
+	Distribution NameDistribution
+}
+
+// Household limits
+const (
+	MaxHouseholdChildren = 8
+	minParentAgeAtBirth  = 18
+	maxParentAgeAtBirth  = 45
+)
+
+// GenerateHousehold generates a family sharing the same address
+// Children carry the last surname of the mother followed by the last surname of the father,
+// and their filiation points to the generated parents
+func (g *Generator) GenerateHousehold(opts HouseholdOptions) *models.Household {
+	children := opts.Children
+	if children < 0 {
+		children = rand.Intn(4)
+	}
+	if children > MaxHouseholdChildren {
+		children = MaxHouseholdChildren
+	}
+
+	address := g.GenerateAddress(opts.State, "")
+	stateCode := address.State
+
+	// Ages: the mother must have been between 18 and 45 years old at the birth of the children
+	motherMinAge, motherMaxAge := 20, 75
+	if children > 0 {
+		motherMaxAge = 72
+	}
+	if opts.WithParents {
+		// Leave room for the parents of the head to still be alive
+		motherMaxAge = 60
+	}
+	motherAge := motherMinAge + rand.Intn(motherMaxAge-motherMinAge+1)
+	fatherAge := clampAge(motherAge-3+rand.Intn(12), motherMinAge, DefaultMaxAge)
+
+	// Surnames: [maternal, paternal] for each spouse
+	profile := g.newNameProfile(opts.Distribution, stateCode, "")
+	fatherLastNames := g.generateLastNames(2, profile)
+	motherBirthLastNames := g.generateLastNames(2, profile)
+	fatherSurname := fatherLastNames[len(fatherLastNames)-1]
+	motherSurname := motherBirthLastNames[len(motherBirthLastNames)-1]
+
+	// Half of the married women adopt the surname of the husband
+	motherLastNames := motherBirthLastNames
+	if rand.Intn(2) == 0 {
+		motherLastNames = append(append([]string{}, motherBirthLastNames...), fatherSurname)
+	}
+
+	members := make([]models.HouseholdMember, 0, 2+children+2)
+
+	var fatherFiliation *models.Filiation
+	var grandparents []*models.Person
+	if opts.WithParents {
+		grandfather, grandmother := g.generateGrandparents(fatherLastNames, fatherAge, stateCode, address, profile)
+		fatherFiliation = &models.Filiation{
+			Father: grandfather.Name.FullName,
+			Mother: grandmother.Name.FullName,
+		}
+		grandparents = []*models.Person{grandfather, grandmother}
+	}
+
+	father := g.buildPerson(PersonOptions{
+		Gender:       "male",
+		State:        stateCode,
+		Distribution: opts.Distribution,
+		MinAge:       fatherAge,
+		MaxAge:       fatherAge,
+	}, personSeed{
+		lastNames:     fatherLastNames,
+		filiation:     fatherFiliation,
+		address:       address,
+		maritalStatus: "married",
+	})
+
+	motherProfile := profile
+	motherProfile.birthYear = time.Now().Year() - motherAge
+	mother := g.buildPerson(PersonOptions{
+		Gender:       "female",
+		State:        stateCode,
+		Distribution: opts.Distribution,
+		MinAge:       motherAge,
+		MaxAge:       motherAge,
+	}, personSeed{
+		lastNames:     motherLastNames,
+		filiation:     ptrFiliation(g.generateFiliation(motherBirthLastNames, motherProfile)),
+		address:       address,
+		maritalStatus: "married",
+	})
+
+	members = append(members,
+		models.HouseholdMember{Role: "head", Person: *father},
+		models.HouseholdMember{Role: "spouse", Person: *mother},
+	)
+
+	childFiliation := &models.Filiation{
+		Father: father.Name.FullName,
+		Mother: mother.Name.FullName,
+	}
+	youngestParentAge := min(father.Age, mother.Age)
+	childMinAge := max(0, mother.Age-maxParentAgeAtBirth)
+	childMaxAge := youngestParentAge - minParentAgeAtBirth
+
+	for i := 0; i < children && childMinAge <= childMaxAge; i++ {
+		genders := []string{"male", "female"}
+		child := g.buildPerson(PersonOptions{
+			Gender:       genders[rand.Intn(len(genders))],
+			State:        stateCode,
+			Distribution: opts.Distribution,
+			MinAge:       childMinAge,
+			MaxAge:       childMaxAge,
+		}, personSeed{
+			lastNames:     []string{motherSurname, fatherSurname},
+			filiation:     childFiliation,
+			address:       address,
+			maritalStatus: "single",
+		})
+		members = append(members, models.HouseholdMember{Role: "child", Person: *child})
+	}
+
+	// Both spouses hold the same marriage certificate, each one naming the other
+	certificate := g.generatePersonMarriageCertificate(stateCode, householdMarriageDate(father, mother, members[2:]), "")
+	members[0].Person.MarriageCertificate = withSpouse(certificate, mother.Name.FullName)
+	members[1].Person.MarriageCertificate = withSpouse(certificate, father.Name.FullName)
+
+	for _, grandparent := range grandparents {
+		members = append(members, models.HouseholdMember{Role: "parent", Person: *grandparent})
+	}
+
+	return &models.Household{
+		Address: *address,
+		Members: members,
+	}
+}
+
+// generateGrandparents generates the parents of a person from their last names
+// The person's last surname comes from the father and the first one from the mother
+func (g *Generator) generateGrandparents(lastNames []string, age int, stateCode string, address *models.Address, profile nameProfile) (grandfather, grandmother *models.Person) {
+	paternal := lastNames[len(lastNames)-1]
+	maternal := lastNames[0]
+
+	grandfatherAge := clampAge(age+minParentAgeAtBirth+2+rand.Intn(maxParentAgeAtBirth-minParentAgeAtBirth-2), age+minParentAgeAtBirth, 100)
+	grandmotherAge := clampAge(grandfatherAge-3+rand.Intn(6), age+minParentAgeAtBirth, age+maxParentAgeAtBirth)
+
+	grandfather = g.buildPerson(PersonOptions{
+		Gender:       "male",
+		State:        stateCode,
+		Distribution: profile.distribution,
+		MinAge:       grandfatherAge,
+		MaxAge:       grandfatherAge,
+	}, personSeed{
+		lastNames:     []string{g.pickLastName(profile), paternal},
+		address:       address,
+		maritalStatus: "married",
+	})
+
+	grandmother = g.buildPerson(PersonOptions{
+		Gender:       "female",
+		State:        stateCode,
+		Distribution: profile.distribution,
+		MinAge:       grandmotherAge,
+		MaxAge:       grandmotherAge,
+	}, personSeed{
+		lastNames:     []string{g.pickLastName(profile), maternal},
+		address:       address,
+		maritalStatus: "married",
+	})
+
+	return grandfather, grandmother
+}
+
+// householdMarriageDate picks the marriage date of the couple of a household
+// Both spouses are adults at the marriage, which happens before the birth of the oldest child when possible
+func householdMarriageDate(husband, wife *models.Person, children []models.HouseholdMember) time.Time {
+	var earliest time.Time
+	for _, spouse := range []*models.Person{husband, wife} {
+		if birth, err := time.Parse("2006-01-02", spouse.Birthdate); err == nil {
+			if adult := birth.AddDate(AdultAge, 0, 0); adult.After(earliest) {
+				earliest = adult
+			}
+		}
+	}
+
+	latest := time.Now()
+	for _, child := range children {
+		if birth, err := time.Parse("2006-01-02", child.Person.Birthdate); err == nil && birth.After(earliest) && birth.Before(latest) {
+			latest = birth
+		}
+	}
+
+	days := int(latest.Sub(earliest).Hours() / 24)
+	return earliest.AddDate(0, 0, rand.Intn(max(days, 1)))
+}
+
+// withSpouse returns a copy of the marriage certificate naming the given spouse
+func withSpouse(certificate *models.PersonMarriageCertificate, spouse string) *models.PersonMarriageCertificate {
+	copied := *certificate
+	copied.Spouse = spouse
+	return &copied
+}
+
+// clampAge limits an age to the given bounds
+func clampAge(age, minAge, maxAge int) int {
+	if age < minAge {
+		return minAge
+	}
+	if age > maxAge {
+		return maxAge
+	}
+	return age
+}
+
+// ptrFiliation returns a pointer to a copy of the filiation
+func ptrFiliation(filiation models.Filiation) *models.Filiation {
+	return &filiation
+}
@@ -0,0 +1,148 @@
+package generators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for household generation focusing on:
+// 1. Shared address
+// 2. Surnames and filiation of children
+// 3. Age consistency between parents and children
+// 4. Parents of the head of the household
+// 5. Marriage certificate shared by the couple
+
+func TestGenerateHousehold_SharedAddress(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	household := gen.GenerateHousehold(HouseholdOptions{State: "RJ", Children: 2})
+
+	assert.Len(t, household.Members, 4, "Household should have head, spouse and 2 children")
+	for _, member := range household.Members {
+		assert.Equal(t, household.Address, member.Person.Address, "All members should share the address")
+		if member.Person.RG != nil {
+			assert.Equal(t, "RJ", member.Person.RG.State, "RG state should match the household state")
+		}
+	}
+}
+
+func TestGenerateHousehold_ChildrenConsistency(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	household := gen.GenerateHousehold(HouseholdOptions{Children: 3})
+
+	head := household.Members[0].Person
+	spouse := household.Members[1].Person
+	assert.Equal(t, "head", household.Members[0].Role)
+	assert.Equal(t, "spouse", household.Members[1].Role)
+	assert.Equal(t, "married", head.MaritalStatus)
+	assert.Equal(t, "married", spouse.MaritalStatus)
+
+	headSurnames := strings.Fields(head.Name.LastName)
+	fatherSurname := headSurnames[len(headSurnames)-1]
+
+	for _, member := range household.Members[2:] {
+		child := member.Person
+		assert.Equal(t, "child", member.Role)
+		assert.Equal(t, head.Name.FullName, child.Filiation.Father, "Child father should be the head")
+		assert.Equal(t, spouse.Name.FullName, child.Filiation.Mother, "Child mother should be the spouse")
+		assert.True(t, strings.HasSuffix(child.Name.LastName, fatherSurname), "Child should carry the father surname")
+		assert.GreaterOrEqual(t, spouse.Age-child.Age, minParentAgeAtBirth, "Mother should be at least 18 at birth")
+		assert.GreaterOrEqual(t, head.Age-child.Age, minParentAgeAtBirth, "Father should be at least 18 at birth")
+	}
+}
+
+func TestGenerateHousehold_WithParents(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	household := gen.GenerateHousehold(HouseholdOptions{Children: 0, WithParents: true})
+
+	assert.Len(t, household.Members, 4, "Household should have head, spouse and the head's parents")
+	head := household.Members[0].Person
+	grandfather := household.Members[2].Person
+	grandmother := household.Members[3].Person
+
+	assert.Equal(t, "parent", household.Members[2].Role)
+	assert.Equal(t, grandfather.Name.FullName, head.Filiation.Father, "Head filiation should point to the generated father")
+	assert.Equal(t, grandmother.Name.FullName, head.Filiation.Mother, "Head filiation should point to the generated mother")
+	assert.Greater(t, grandfather.Age, head.Age)
+}
+
+func TestGenerateFiliation_SharesSurnames(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	filiation := gen.generateFiliation([]string{"Souza", "Lima"}, nameProfile{distribution: NameDistributionUniform})
+
+	assert.True(t, strings.HasSuffix(filiation.Father, " Lima"), "Father should carry the last surname")
+	assert.Contains(t, filiation.Mother, "Souza", "Mother should carry the first surname")
+}
+
+func TestGenerateHousehold_MinorChildren(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	minors := 0
+	for i := 0; i < 30; i++ {
+		household := gen.GenerateHousehold(HouseholdOptions{Children: 2})
+		for _, member := range household.Members {
+			if member.Role != "child" || !isMinor(member.Person.Age) {
+				continue
+			}
+			minors++
+			assert.Nil(t, member.Person.Guardian, "Parents of household minors are already members")
+			assert.Empty(t, member.Person.MaritalStatus)
+			assert.NotNil(t, member.Person.BirthCertificate)
+		}
+	}
+
+	assert.Greater(t, minors, 0, "Households should include minor children")
+}
+
+func TestGenerateHousehold_MarriageCertificate(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 20; i++ {
+		household := gen.GenerateHousehold(HouseholdOptions{State: "MG", Children: 2, WithParents: true})
+		head, spouse := household.Members[0].Person, household.Members[1].Person
+
+		if !assert.NotNil(t, head.MarriageCertificate) || !assert.NotNil(t, spouse.MarriageCertificate) {
+			continue
+		}
+		assert.Equal(t, head.MarriageCertificate.Unmasked, spouse.MarriageCertificate.Unmasked, "The couple should share the certificate")
+		assert.Equal(t, spouse.Name.FullName, head.MarriageCertificate.Spouse)
+		assert.Equal(t, head.Name.FullName, spouse.MarriageCertificate.Spouse)
+		assert.Equal(t, "MG", head.MarriageCertificate.State)
+		assert.True(t, ValidateCertificateNumber(head.MarriageCertificate.Number))
+
+		for _, member := range []*models.Person{&head, &spouse} {
+			adult, _ := time.Parse("2006-01-02", member.Birthdate)
+			assert.GreaterOrEqual(t, head.MarriageCertificate.RegistrationDate, adult.AddDate(AdultAge, 0, 0).Format("2006-01-02"), "Spouses should be adults at the marriage")
+		}
+		for _, member := range household.Members[2:] {
+			assert.Nil(t, member.Person.MarriageCertificate, "Only the couple has the marriage certificate")
+		}
+	}
+}
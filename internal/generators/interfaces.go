@@ -7,23 +7,41 @@ type DocumentGenerator interface {
 	GenerateCPF(formatted bool, valid bool) string
 	GenerateCNPJ(formatted bool, valid bool) string
 	GenerateRG(stateCode string, formatted bool, valid bool) (rg, state, issuer, issueDate, expirationDate string)
+	GenerateCertificate(opts CertificateOptions) *models.CertificateResponse
+	GeneratePassport(birthdate string) *models.PassportResponse
+	GenerateCouncilRegistration(council, stateCode string) *models.CouncilRegistrationResponse
 }
 
 // ContactGenerator define interface for generating contact information
 type ContactGenerator interface {
 	GenerateEmail(customDomain string) (email, username, domain string)
 	GeneratePhone(stateCode, requestedType string) (phone, ddd, state, phoneType string)
+	GeneratePhoneInCity(stateCode, city, requestedType string) (phone, ddd, state, phoneType string)
 }
 
 // PersonGenerator define interface for generating person profiles
 type PersonGenerator interface {
 	GeneratePerson(gender, stateCode string) *models.Person
+	GeneratePersonWithOptions(opts PersonOptions) *models.Person
+	NormalizePersonOptions(opts PersonOptions) (PersonOptions, error)
+	GenerateHousehold(opts HouseholdOptions) *models.Household
 }
 
 // AddressGenerator define interface for generating addresses
 type AddressGenerator interface {
 	GenerateAddress(stateCode, city string) *models.Address
-	GenerateZipcodeDetails(stateCode string) (formatted, unformatted, state, city string)
+	GenerateZipcodeDetails(stateCode, cityName string) (formatted, unformatted, state, city string)
+	LookupZipcode(cep string) *models.ZipcodeLookupResponse
+	NormalizeLocationOptions(opts LocationOptions) (LocationOptions, error)
+	PickLocation(opts LocationOptions) (stateCode, city string)
+	ListStates() []models.StateResponse
+	ListCities(stateCode string) ([]models.CityResponse, bool)
+	ListDistricts(stateCode, cityName string) ([]models.DistrictResponse, bool)
+	ListStreets(stateCode, cityName, districtName string, page, pageSize int) (*models.StreetPage, bool)
+	FindNearbyAddresses(lat, lng, radiusKm float64, quantity int) *models.NearbyAddressesResponse
+	ReverseGeocode(lat, lng float64) *models.NearbyAddressResponse
+	AutocompleteStreets(query, stateCode, city string, limit int) *models.AutocompleteResponse
+	ValidateAddress(req models.AddressValidationRequest) *models.AddressValidationResponse
 }
 
 // FinancialGenerator define interface for generating financial data
@@ -35,6 +53,10 @@ type FinancialGenerator interface {
 // CompanyGenerator define interface for generating companies
 type CompanyGenerator interface {
 	GenerateCompany() *models.CompanyResponse
+	GenerateCompanyWithOptions(opts CompanyOptions) *models.CompanyResponse
+	GenerateCompanyGroup(opts CompanyOptions, branches int) *models.CompanyGroupResponse
+	GenerateMEI(stateCode, activity string) *models.MEIResponse
+	GenerateCompanyEmployees(opts CompanyOptions, cnpj string, count int) *models.CompanyEmployeesResponse
 }
 
 // DataStoreProvider define interface for accessing the DataStore
@@ -0,0 +1,188 @@
+package generators
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// LocationOptions contains the constraints on where a generated address, phone or company is located
+// Zero values mean no constraint
+type LocationOptions struct {
+	State string
+	// City restricts the location to a city, ignoring case and accents; it must exist in the state when both are given
+	City string
+	// Region restricts the location to a region (ex: Nordeste), ignoring case and accents
+	Region string
+	// Capital restricts the location to state capitals (true) or to the other cities (false)
+	// Only capitals covered by the address database are picked
+	Capital *bool
+}
+
+// LocationOptionError reports an invalid location constraint
+type LocationOptionError struct {
+	Parameter string
+	Message   string
+}
+
+// Error implements the error interface
+func (e *LocationOptionError) Error() string {
+	return e.Message
+}
+
+// newLocationOptionError creates a LocationOptionError with a formatted message
+func newLocationOptionError(parameter, format string, args ...interface{}) *LocationOptionError {
+	return &LocationOptionError{
+		Parameter: parameter,
+		Message:   fmt.Sprintf(format, args...),
+	}
+}
+
+// NormalizeLocationOptions validates the constraints and resolves them to canonical values
+// A city fills an empty state, and constraints that no city of the address database satisfies are rejected
+func (g *Generator) NormalizeLocationOptions(opts LocationOptions) (LocationOptions, error) {
+	ds := g.dataStore
+
+	if opts.State != "" {
+		state := ds.GetStateByCode(opts.State)
+		if state == nil {
+			return opts, newLocationOptionError("state", "state must be a valid state code (ex: SP, RJ)")
+		}
+		opts.State = state.Code
+	}
+
+	if opts.Region != "" {
+		region, ok := matchOption(opts.Region, ds.GetRegions())
+		if !ok {
+			return opts, newLocationOptionError("region", "region must be one of: %s", strings.Join(ds.GetRegions(), ", "))
+		}
+		opts.Region = region
+		if opts.State != "" && ds.GetStateByCode(opts.State).Region != region {
+			return opts, newLocationOptionError("region", "state %s is not in region %s", opts.State, region)
+		}
+	}
+
+	if opts.City != "" {
+		state, city, ok := ds.FindCity(opts.State, opts.City)
+		if !ok && opts.State != "" {
+			return opts, newLocationOptionError("city", "city %q not found in state %s", opts.City, opts.State)
+		}
+		if !ok {
+			return opts, newLocationOptionError("city", "city %q not found", opts.City)
+		}
+		if opts.Region != "" && ds.GetStateByCode(state).Region != opts.Region {
+			return opts, newLocationOptionError("city", "city %s (%s) is not in region %s", city, state, opts.Region)
+		}
+		opts.State, opts.City = state, city
+	}
+
+	if opts.Capital != nil {
+		if err := g.checkCapitalOption(opts); err != nil {
+			return opts, err
+		}
+	}
+
+	return opts, nil
+}
+
+// checkCapitalOption checks that some city of the address database satisfies the capital constraint
+func (g *Generator) checkCapitalOption(opts LocationOptions) error {
+	ds := g.dataStore
+	capital := *opts.Capital
+
+	if opts.City != "" {
+		isCapital := ds.IsCapital(opts.State, opts.City)
+		if capital && !isCapital {
+			return newLocationOptionError("capital", "city %s is not the capital of %s", opts.City, opts.State)
+		}
+		if !capital && isCapital {
+			return newLocationOptionError("capital", "city %s is the capital of %s", opts.City, opts.State)
+		}
+		return nil
+	}
+
+	if len(g.locationStates(opts)) > 0 {
+		return nil
+	}
+	switch {
+	case capital && opts.State != "":
+		return newLocationOptionError("capital", "the capital of %s is not covered by the address database", opts.State)
+	case capital:
+		return newLocationOptionError("capital", "no capital of region %s is covered by the address database", opts.Region)
+	case opts.State != "":
+		return newLocationOptionError("capital", "state %s has no city other than its capital in the address database", opts.State)
+	default:
+		return newLocationOptionError("capital", "region %s has no city other than its capitals in the address database", opts.Region)
+	}
+}
+
+// PickLocation picks the state and city of a location satisfying the normalized constraints
+// The city is empty when any city of the state fits, and the state is empty when any state fits
+func (g *Generator) PickLocation(opts LocationOptions) (stateCode, city string) {
+	if opts.City != "" || (opts.Region == "" && opts.Capital == nil) {
+		return opts.State, opts.City
+	}
+
+	states := g.locationStates(opts)
+	if len(states) == 0 {
+		return opts.State, ""
+	}
+	stateCode = states[rand.Intn(len(states))]
+
+	switch {
+	case opts.Capital == nil:
+		return stateCode, ""
+	case *opts.Capital:
+		city, _ = g.dataStore.GetCapital(stateCode)
+		return stateCode, city
+	default:
+		cities := g.nonCapitalCities(stateCode)
+		return stateCode, cities[rand.Intn(len(cities))]
+	}
+}
+
+// locationStates returns the codes of the states satisfying the state, region and capital constraints
+func (g *Generator) locationStates(opts LocationOptions) []string {
+	ds := g.dataStore
+
+	var codes []string
+	switch {
+	case opts.State != "":
+		codes = []string{opts.State}
+	case opts.Region != "":
+		codes = ds.GetStateCodesInRegion(opts.Region)
+	default:
+		for _, state := range ds.GetStates() {
+			codes = append(codes, state.Code)
+		}
+	}
+	if opts.Capital == nil {
+		return codes
+	}
+
+	states := make([]string, 0, len(codes))
+	for _, code := range codes {
+		_, covered := ds.GetCapital(code)
+		if (*opts.Capital && covered) || (!*opts.Capital && len(g.nonCapitalCities(code)) > 0) {
+			states = append(states, code)
+		}
+	}
+	return states
+}
+
+// nonCapitalCities returns the cities of a state other than its capital
+func (g *Generator) nonCapitalCities(stateCode string) []string {
+	ds := g.dataStore
+	state := ds.GetStateByCode(stateCode)
+	if state == nil {
+		return nil
+	}
+
+	cities := make([]string, 0, len(state.Cities))
+	for _, city := range state.Cities {
+		if !ds.IsCapital(stateCode, city) {
+			cities = append(cities, city)
+		}
+	}
+	return cities
+}
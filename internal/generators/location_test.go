@@ -0,0 +1,134 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for the location options focusing on:
+// 1. Normalization of the region and rejection of inconsistent state, city and region
+// 2. Capitals restricted to the ones covered by the address database
+// 3. Picked locations satisfying the constraints
+
+func TestNormalizeLocationOptions_Region(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	opts, err := gen.NormalizeLocationOptions(LocationOptions{Region: "nordeste"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Nordeste", opts.Region)
+
+	_, err = gen.NormalizeLocationOptions(LocationOptions{Region: "centro oeste"})
+	assert.Error(t, err)
+
+	opts, err = gen.NormalizeLocationOptions(LocationOptions{Region: "CENTRO-OESTE", State: "df"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Centro-Oeste", opts.Region)
+	assert.Equal(t, "DF", opts.State)
+}
+
+func TestNormalizeLocationOptions_Invalid(t *testing.T) {
+	gen := newTestGenerator(t)
+	yes, no := true, false
+
+	tests := []struct {
+		name      string
+		opts      LocationOptions
+		parameter string
+	}{
+		{"unknown region", LocationOptions{Region: "Leste"}, "region"},
+		{"state out of region", LocationOptions{State: "SP", Region: "Sul"}, "region"},
+		{"city out of region", LocationOptions{City: "Manaus", Region: "Nordeste"}, "city"},
+		{"unknown city", LocationOptions{State: "SP", City: "Manaus"}, "city"},
+		{"city is not the capital", LocationOptions{City: "Eusébio", Capital: &yes}, "capital"},
+		{"city is the capital", LocationOptions{City: "Natal", Capital: &no}, "capital"},
+		{"capital not covered", LocationOptions{State: "SP", Capital: &yes}, "capital"},
+		{"no capital covered in region", LocationOptions{Region: "Sul", Capital: &yes}, "capital"},
+		{"state with only the capital", LocationOptions{State: "AC", Capital: &no}, "capital"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gen.NormalizeLocationOptions(tt.opts)
+			if assert.Error(t, err) {
+				optionErr, ok := err.(*LocationOptionError)
+				assert.True(t, ok)
+				assert.Equal(t, tt.parameter, optionErr.Parameter)
+			}
+		})
+	}
+}
+
+func TestPickLocation_Capital(t *testing.T) {
+	gen := newTestGenerator(t)
+	yes := true
+
+	opts, err := gen.NormalizeLocationOptions(LocationOptions{Region: "Nordeste", Capital: &yes})
+	assert.NoError(t, err)
+
+	for i := 0; i < 50; i++ {
+		state, city := gen.PickLocation(opts)
+		assert.Equal(t, "Nordeste", gen.dataStore.GetStateByCode(state).Region)
+		assert.True(t, gen.dataStore.IsCapital(state, city), "%s should be the capital of %s", city, state)
+	}
+}
+
+func TestPickLocation_NotCapital(t *testing.T) {
+	gen := newTestGenerator(t)
+	no := false
+
+	opts, err := gen.NormalizeLocationOptions(LocationOptions{Capital: &no})
+	assert.NoError(t, err)
+
+	for i := 0; i < 50; i++ {
+		state, city := gen.PickLocation(opts)
+		assert.NotEmpty(t, city)
+		assert.False(t, gen.dataStore.IsCapital(state, city), "%s should not be the capital of %s", city, state)
+	}
+}
+
+func TestPickLocation_Unconstrained(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	state, city := gen.PickLocation(LocationOptions{State: "SP"})
+	assert.Equal(t, "SP", state)
+	assert.Empty(t, city)
+
+	state, city = gen.PickLocation(LocationOptions{Region: "Sul"})
+	assert.Contains(t, []string{"PR", "RS", "SC"}, state)
+	assert.Empty(t, city)
+}
+
+func TestGenerateAddress_IBGECode(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	address := gen.GenerateAddress("AC", "")
+
+	assert.Equal(t, "Rio Branco", address.City)
+	assert.Equal(t, "1200401", address.IBGECode)
+}
+
+func TestGetCapital(t *testing.T) {
+	gen := newTestGenerator(t)
+	ds := gen.dataStore
+
+	capital, ok := ds.GetCapital("df")
+	assert.True(t, ok)
+	assert.Equal(t, "Brasilia", capital, "The capital should match the city of the database ignoring accents")
+
+	_, ok = ds.GetCapital("SP")
+	assert.False(t, ok, "São Paulo is not among the cities of the database")
+
+	assert.True(t, ds.IsCapital("MG", "belo horizonte"))
+	assert.False(t, ds.IsCapital("CE", "Eusébio"))
+}
+
+func TestGeneratePhoneInCity(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 10; i++ {
+		_, ddd, state, _ := gen.GeneratePhoneInCity("AC", "Rio Branco", "mobile")
+		assert.Equal(t, "68", ddd)
+		assert.Equal(t, "AC", state)
+	}
+}
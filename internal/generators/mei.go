@@ -0,0 +1,130 @@
+package generators
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Legal nature of the microempreendedor individual (MEI)
+const (
+	meiLegalNatureCode        = "213-5"
+	meiLegalNatureDescription = "Empresário (Individual)"
+)
+
+// meiRevenueCeiling is the maximum annual revenue in BRL of a MEI (Lei Complementar 155/2016)
+const meiRevenueCeiling = 81000
+
+// meiMinRevenue is the minimum annual revenue in BRL of generated MEIs
+const meiMinRevenue = 6000
+
+// meiCreationDate is the date the first MEIs could be registered (Lei Complementar 128/2008)
+var meiCreationDate = time.Date(2009, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+// Age of the owners of generated MEIs; owners are at least 19 so the opening date has room after their 18th birthday
+const (
+	minMEIOwnerAge = 19
+	maxMEIOwnerAge = 70
+)
+
+// GenerateMEI generates a microempreendedor individual: a person and the CNPJ registered in their name
+// The razão social is the full name followed by the CPF, the activity is allowed for MEI, the company opened
+// after the owner turned 18 and shares their address and contacts, and the annual revenue is under the ceiling
+// The profession of the owner follows the activity and is never a regulated one
+// A random state and MEI activity are used when they are empty or unknown
+func (g *Generator) GenerateMEI(stateCode, activity string) *models.MEIResponse {
+	ds := g.dataStore
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	hasCompany := false
+	owner := g.GeneratePersonWithOptions(PersonOptions{
+		State:       ds.ValidateAndSanitizeState(stateCode),
+		MinAge:      minMEIOwnerAge,
+		MaxAge:      maxMEIOwnerAge,
+		Nationality: NationalityBrazilian,
+		HasCompany:  &hasCompany,
+	})
+
+	activities := ds.FindMEIActivities(activity)
+	if len(activities) == 0 {
+		activities = ds.GetMEIActivities()
+	}
+	mainActivity := activities[rand.Intn(len(activities))]
+	owner.Profession = g.generateMEIOwnerProfession(mainActivity)
+
+	name := fmt.Sprintf("%s %s", strings.ToUpper(owner.Name.FullName), owner.CPF.Unmasked)
+	cnpj := FormatCNPJ(BuildCNPJ(generateCNPJRoot(), 1))
+	owner.Company = &models.PersonCompany{Name: name, CNPJ: cnpj}
+
+	birthdate, _ := time.Parse("2006-01-02", owner.Birthdate)
+	openedAt := generateMEIOpeningDate(birthdate, today)
+	revenue := math.Round((meiMinRevenue+rand.Float64()*(meiRevenueCeiling-meiMinRevenue))*100) / 100
+
+	return &models.MEIResponse{
+		Name:               name,
+		TradeName:          owner.Name.FirstName + " " + owner.Name.LastName,
+		CNPJ:               cnpj,
+		LegalNature:        models.CompanyLegalNature{Code: meiLegalNatureCode, Description: meiLegalNatureDescription},
+		OpenedAt:           openedAt.Format("2006-01-02"),
+		Status:             CompanyStatusActive,
+		SimeiOptant:        true,
+		MainActivity:       companyActivity(mainActivity),
+		AnnualRevenue:      FormatBRL(revenue),
+		AnnualRevenueValue: revenue,
+		Email:              owner.Email.Address,
+		Phone:              owner.Phone.NationalFormat,
+		Address:            owner.Address,
+		Owner:              *owner,
+	}
+}
+
+// generateMEIOwnerProfession generates the profession of the owner of a MEI in one of the areas of its activity
+// Regulated professions cannot be exercised as MEI, so professions with a council are never used and the
+// owner has no council registration; any unregulated profession is used when the areas have none
+func (g *Generator) generateMEIOwnerProfession(activity ActivityData) *models.PersonProfession {
+	ds := g.dataStore
+
+	areas := make(map[string]bool, len(activity.Areas))
+	for _, area := range activity.Areas {
+		areas[area] = true
+	}
+
+	var inArea, unregulated []ProfessionData
+	for _, profession := range ds.GetProfessions() {
+		if profession.Council != "" {
+			continue
+		}
+		unregulated = append(unregulated, profession)
+		if areas[profession.Area] {
+			inArea = append(inArea, profession)
+		}
+	}
+	if len(inArea) == 0 {
+		inArea = unregulated
+	}
+
+	profession := inArea[rand.Intn(len(inArea))]
+	salary := generateSalary(ds.GetSalaryRange(profession), ds.GetMinimumWage())
+
+	return &models.PersonProfession{
+		Title:       profession.Title,
+		Area:        profession.Area,
+		Salary:      FormatBRL(salary),
+		SalaryValue: salary,
+	}
+}
+
+// generateMEIOpeningDate generates the opening date of a MEI, after the owner turned 18 and the MEI
+// was created, and at least 30 days before today
+func generateMEIOpeningDate(birthdate, today time.Time) time.Time {
+	earliest := birthdate.AddDate(18, 0, 0)
+	if earliest.Before(meiCreationDate) {
+		earliest = meiCreationDate
+	}
+	latest := today.AddDate(0, 0, -30)
+	return earliest.AddDate(0, 0, rand.Intn(max(int(latest.Sub(earliest).Hours()/24), 0)+1))
+}
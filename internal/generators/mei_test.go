@@ -0,0 +1,119 @@
+package generators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for MEI generation focusing on:
+// 1. Razão social following the MEI convention (full name and CPF)
+// 2. Activities allowed for MEI and revenue under the ceiling
+// 3. Opening date after the owner turned 18
+// 4. Address and contacts shared with the owner
+// 5. Unregulated owner profession following the activity
+
+func TestGenerateMEI_Name(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		mei := gen.GenerateMEI("", "")
+
+		assert.Equal(t, strings.ToUpper(mei.Owner.Name.FullName)+" "+mei.Owner.CPF.Unmasked, mei.Name)
+		assert.True(t, ValidateCNPJ(mei.CNPJ), mei.CNPJ)
+		assert.Equal(t, meiLegalNatureCode, mei.LegalNature.Code)
+		if assert.NotNil(t, mei.Owner.Company) {
+			assert.Equal(t, mei.Name, mei.Owner.Company.Name)
+			assert.Equal(t, mei.CNPJ, mei.Owner.Company.CNPJ)
+		}
+	}
+}
+
+func TestGenerateMEI_ActivityAndRevenue(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	allowed := make(map[string]bool)
+	for _, activity := range gen.dataStore.GetMEIActivities() {
+		allowed[activity.Code] = true
+	}
+
+	for i := 0; i < 100; i++ {
+		mei := gen.GenerateMEI("", "")
+
+		assert.True(t, allowed[mei.MainActivity.Code], mei.MainActivity.Code)
+		assert.LessOrEqual(t, mei.AnnualRevenueValue, float64(meiRevenueCeiling))
+		assert.Greater(t, mei.AnnualRevenueValue, 0.0)
+		assert.Equal(t, FormatBRL(mei.AnnualRevenueValue), mei.AnnualRevenue)
+		assert.True(t, mei.SimeiOptant)
+		assert.Equal(t, CompanyStatusActive, mei.Status)
+	}
+
+	mei := gen.GenerateMEI("", "9602")
+	assert.True(t, strings.HasPrefix(mei.MainActivity.Code, "9602"), mei.MainActivity.Code)
+}
+
+func TestGenerateMEI_OpeningDate(t *testing.T) {
+	gen := newTestGenerator(t)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for i := 0; i < 100; i++ {
+		mei := gen.GenerateMEI("", "")
+
+		birthdate, err := time.Parse("2006-01-02", mei.Owner.Birthdate)
+		assert.NoError(t, err)
+		openedAt, err := time.Parse("2006-01-02", mei.OpenedAt)
+		assert.NoError(t, err)
+
+		assert.False(t, openedAt.Before(birthdate.AddDate(18, 0, 0)), "The owner should be an adult at the opening")
+		assert.False(t, openedAt.Before(meiCreationDate), "MEIs only exist since July 2009")
+		assert.True(t, openedAt.Before(today))
+	}
+}
+
+func TestGenerateMEI_SharesOwnerData(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	mei := gen.GenerateMEI("BA", "")
+
+	assert.Equal(t, "BA", mei.Address.State)
+	assert.Equal(t, mei.Owner.Address, mei.Address)
+	assert.Equal(t, mei.Owner.Email.Address, mei.Email)
+	assert.Equal(t, mei.Owner.Phone.NationalFormat, mei.Phone)
+	assert.GreaterOrEqual(t, mei.Owner.Age, 18)
+}
+
+func TestFindMEIActivities(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	assert.NotEmpty(t, gen.dataStore.FindMEIActivities("96"))
+	assert.Equal(t, gen.dataStore.GetMEIActivities(), gen.dataStore.FindMEIActivities(""))
+	assert.Empty(t, gen.dataStore.FindMEIActivities("6201"), "Software development is not allowed for MEI")
+}
+
+func TestGenerateMEI_OwnerProfession(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	regulated := make(map[string]bool)
+	for _, profession := range gen.dataStore.GetProfessions() {
+		if profession.Council != "" {
+			regulated[profession.Title] = true
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		mei := gen.GenerateMEI("", "")
+
+		if assert.NotNil(t, mei.Owner.Profession) {
+			assert.Nil(t, mei.Owner.Profession.Registration, "MEI owners should have no council registration")
+			assert.False(t, regulated[mei.Owner.Profession.Title], "%s is a regulated profession", mei.Owner.Profession.Title)
+			assert.Greater(t, mei.Owner.Profession.SalaryValue, 0.0)
+		}
+	}
+
+	for i := 0; i < 20; i++ {
+		mei := gen.GenerateMEI("", "4321-5/00")
+		assert.Contains(t, []string{"Manutenção", "Engenharia"}, mei.Owner.Profession.Area, "The profession should follow the activity")
+	}
+}
@@ -0,0 +1,107 @@
+package generators
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// childGrowth contains the median height (cm) and weight (kg) by age for boys and girls
+// Index is the age in years, from 0 to 17
+var childGrowth = []struct {
+	maleHeight, femaleHeight float64
+	maleWeight, femaleWeight float64
+}{
+	{67, 65, 7.5, 7.0},
+	{80, 79, 10.9, 10.2},
+	{88, 87, 12.7, 12.1},
+	{96, 95, 14.3, 13.9},
+	{103, 102, 16.3, 16.0},
+	{110, 109, 18.4, 18.2},
+	{116, 115, 20.7, 20.2},
+	{122, 121, 22.9, 22.4},
+	{128, 127, 25.4, 25.0},
+	{133, 133, 28.1, 28.2},
+	{138, 138, 31.2, 31.9},
+	{143, 144, 34.9, 36.0},
+	{149, 151, 39.5, 41.0},
+	{156, 157, 45.0, 45.5},
+	{163, 160, 50.5, 49.0},
+	{169, 162, 56.0, 52.0},
+	{172, 163, 60.5, 54.0},
+	{174, 163, 64.0, 55.5},
+}
+
+// isMinor reports whether a person of the given age is under 18
+func isMinor(age int) bool {
+	return age < AdultAge
+}
+
+// generateChildHeightCm generates the height of a minor around the median of the age
+func generateChildHeightCm(gender string, age int) float64 {
+	growth := childGrowth[clampAge(age, 0, len(childGrowth)-1)]
+	median := growth.femaleHeight
+	if gender == "male" {
+		median = growth.maleHeight
+	}
+	return median * (1 + boundedNorm()*0.035)
+}
+
+// generateChildWeightKg generates the weight of a minor around the median of the age
+func generateChildWeightKg(gender string, age int) float64 {
+	growth := childGrowth[clampAge(age, 0, len(childGrowth)-1)]
+	median := growth.femaleWeight
+	if gender == "male" {
+		median = growth.maleWeight
+	}
+	return median * (1 + boundedNorm()*0.08)
+}
+
+// boundedNorm returns a standard normal value limited to two deviations
+// Keeps height and weight of minors, and so their BMI, within plausible bounds
+func boundedNorm() float64 {
+	return max(-2, min(2, rand.NormFloat64()))
+}
+
+// minorHasRG decides whether a minor already has an RG
+// Most children only get one when starting school or travelling
+func minorHasRG(age int) bool {
+	chance := 30
+	switch {
+	case age >= 12:
+		chance = 85
+	case age >= 6:
+		chance = 60
+	}
+	return rand.Intn(100) < chance
+}
+
+// generateGuardian generates the legal guardian of a minor, who is one of the parents in the filiation
+// The guardian lives at the same address as the minor
+func (g *Generator) generateGuardian(father, mother models.PersonName, childAge int, stateCode string, address *models.Address, distribution NameDistribution) *models.Guardian {
+	relationship, gender, name := "mother", "female", mother
+	if rand.Intn(100) < 15 {
+		relationship, gender, name = "father", "male", father
+	}
+
+	age := childAge + minParentAgeAtBirth + rand.Intn(maxParentAgeAtBirth-minParentAgeAtBirth+1)
+	age = clampAge(age, AdultAge, MaxPersonAge)
+
+	guardian := g.buildPerson(PersonOptions{
+		Gender:       gender,
+		State:        stateCode,
+		MinAge:       age,
+		MaxAge:       age,
+		Distribution: distribution,
+	}, personSeed{
+		firstNames: strings.Fields(name.FirstName),
+		lastNames:  strings.Fields(name.LastName),
+		address:    address,
+	})
+
+	return &models.Guardian{
+		Relationship: relationship,
+		Person:       *guardian,
+	}
+}
@@ -0,0 +1,88 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for minors focusing on:
+// 1. Fields that only apply to adults
+// 2. Education, height and weight matching the age
+// 3. Legal guardian linked through the filiation
+
+func TestGeneratePerson_Minor(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{MaxAge: 12})
+
+		assert.LessOrEqual(t, person.Age, 12)
+		assert.Nil(t, person.Profession, "Minors should not have a profession")
+		assert.Nil(t, person.Company, "Minors should not have a company")
+		assert.Empty(t, person.MaritalStatus, "Minors should not have a marital status")
+		assert.Equal(t, gen.dataStore.GetMinorEducationLevel(person.Age), person.Education)
+		assert.NotNil(t, person.BirthCertificate, "Minors should have a birth certificate")
+		assert.Less(t, person.Height.Centimeters, 175.0)
+		assert.GreaterOrEqual(t, person.BMI, 10.0)
+		if person.RG != nil {
+			assert.GreaterOrEqual(t, person.RG.IssueDate, person.Birthdate, "RG cannot be issued before birth")
+		}
+	}
+}
+
+func TestGeneratePerson_MinorGuardian(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 20; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{MinAge: 5, MaxAge: 15})
+		guardian := person.Guardian
+
+		if !assert.NotNil(t, guardian, "Minors should have a legal guardian") {
+			continue
+		}
+
+		switch guardian.Relationship {
+		case "mother":
+			assert.Equal(t, person.Filiation.Mother, guardian.Person.Name.FullName)
+			assert.Equal(t, "female", guardian.Person.Gender)
+		case "father":
+			assert.Equal(t, person.Filiation.Father, guardian.Person.Name.FullName)
+			assert.Equal(t, "male", guardian.Person.Gender)
+		default:
+			t.Errorf("Unexpected relationship %s", guardian.Relationship)
+		}
+
+		assert.Equal(t, person.Address, guardian.Person.Address, "Guardian should live with the minor")
+		assert.GreaterOrEqual(t, guardian.Person.Age-person.Age, minParentAgeAtBirth)
+		assert.Nil(t, guardian.Person.Guardian)
+		assert.Nil(t, guardian.Person.BirthCertificate)
+	}
+}
+
+func TestGeneratePerson_AdultHasNoMinorFields(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	person := gen.GeneratePerson("", "")
+
+	assert.GreaterOrEqual(t, person.Age, AdultAge)
+	assert.NotNil(t, person.RG)
+	assert.NotNil(t, person.Profession)
+	assert.NotEmpty(t, person.MaritalStatus)
+	assert.Nil(t, person.BirthCertificate)
+	assert.Nil(t, person.Guardian)
+}
+
+func TestNormalizePersonOptions_AdultOnlyConstraints(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	_, err := gen.NormalizePersonOptions(PersonOptions{MaxAge: 15, ProfessionArea: "Tecnologia"})
+	assert.Error(t, err)
+
+	_, err = gen.NormalizePersonOptions(PersonOptions{MaxAge: 15, MaritalStatus: "single"})
+	assert.Error(t, err)
+
+	opts, err := gen.NormalizePersonOptions(PersonOptions{MaxAge: 40, MinSalary: 3000})
+	assert.NoError(t, err)
+	assert.Equal(t, AdultAge, opts.MinAge, "Salary constraints should raise the minimum age to adults")
+}
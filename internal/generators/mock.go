@@ -5,18 +5,40 @@ import "github.com/diogomcd/fake-mill-api/internal/models"
 // MockGenerator is an example of mock Generator for tests
 // Implements the IGenerator interface
 type MockGenerator struct {
-	MockGenerateCPF            func(formatted bool, valid bool) string
-	MockGenerateCNPJ           func(formatted bool, valid bool) string
-	MockGenerateRG             func(stateCode string, formatted bool, valid bool) (rg, state, issuer, issueDate, expirationDate string)
-	MockGenerateEmail          func(customDomain string) (email, username, domain string)
-	MockGeneratePhone          func(stateCode, requestedType string) (phone, ddd, state, phoneType string)
-	MockGeneratePerson         func(gender, stateCode string) *models.Person
-	MockGenerateAddress        func(stateCode, city string) *models.Address
-	MockGenerateZipcodeDetails func(stateCode string) (formatted, unformatted, state, city string)
-	MockGenerateBankAccount    func(bankCode string) (bank Bank, agency, account, accountType string)
-	MockGenerateCreditCard     func(brand string) (number, cardBrand, cvv, expirationDate, holderName string)
-	MockGenerateCompany        func() *models.CompanyResponse
-	MockGetDataStore           func() *DataStore
+	MockGenerateCPF                 func(formatted bool, valid bool) string
+	MockGenerateCNPJ                func(formatted bool, valid bool) string
+	MockGenerateRG                  func(stateCode string, formatted bool, valid bool) (rg, state, issuer, issueDate, expirationDate string)
+	MockGenerateCertificate         func(opts CertificateOptions) *models.CertificateResponse
+	MockGeneratePassport            func(birthdate string) *models.PassportResponse
+	MockGenerateCouncilRegistration func(council, stateCode string) *models.CouncilRegistrationResponse
+	MockGenerateEmail               func(customDomain string) (email, username, domain string)
+	MockGeneratePhone               func(stateCode, requestedType string) (phone, ddd, state, phoneType string)
+	MockGeneratePhoneInCity         func(stateCode, city, requestedType string) (phone, ddd, state, phoneType string)
+	MockGeneratePerson              func(gender, stateCode string) *models.Person
+	MockGeneratePersonWithOpts      func(opts PersonOptions) *models.Person
+	MockNormalizePersonOpts         func(opts PersonOptions) (PersonOptions, error)
+	MockGenerateHousehold           func(opts HouseholdOptions) *models.Household
+	MockGenerateAddress             func(stateCode, city string) *models.Address
+	MockListStates                  func() []models.StateResponse
+	MockListCities                  func(stateCode string) ([]models.CityResponse, bool)
+	MockListDistricts               func(stateCode, cityName string) ([]models.DistrictResponse, bool)
+	MockListStreets                 func(stateCode, cityName, districtName string, page, pageSize int) (*models.StreetPage, bool)
+	MockFindNearbyAddresses         func(lat, lng, radiusKm float64, quantity int) *models.NearbyAddressesResponse
+	MockAutocompleteStreets         func(query, stateCode, city string, limit int) *models.AutocompleteResponse
+	MockValidateAddress             func(req models.AddressValidationRequest) *models.AddressValidationResponse
+	MockReverseGeocode              func(lat, lng float64) *models.NearbyAddressResponse
+	MockLookupZipcode               func(cep string) *models.ZipcodeLookupResponse
+	MockNormalizeLocationOpts       func(opts LocationOptions) (LocationOptions, error)
+	MockPickLocation                func(opts LocationOptions) (stateCode, city string)
+	MockGenerateZipcodeDetails      func(stateCode, cityName string) (formatted, unformatted, state, city string)
+	MockGenerateBankAccount         func(bankCode string) (bank Bank, agency, account, accountType string)
+	MockGenerateCreditCard          func(brand string) (number, cardBrand, cvv, expirationDate, holderName string)
+	MockGenerateCompany             func() *models.CompanyResponse
+	MockGenerateCompanyWithOptions  func(opts CompanyOptions) *models.CompanyResponse
+	MockGenerateCompanyGroup        func(opts CompanyOptions, branches int) *models.CompanyGroupResponse
+	MockGenerateCompanyEmployees    func(opts CompanyOptions, cnpj string, count int) *models.CompanyEmployeesResponse
+	MockGenerateMEI                 func(stateCode, activity string) *models.MEIResponse
+	MockGetDataStore                func() *DataStore
 }
 
 func (m *MockGenerator) GenerateCPF(formatted bool, valid bool) string {
@@ -40,6 +62,27 @@ func (m *MockGenerator) GenerateRG(stateCode string, formatted bool, valid bool)
 	return "000000000", "SP", "SSP", "2020-01-01", "2030-01-01"
 }
 
+func (m *MockGenerator) GenerateCertificate(opts CertificateOptions) *models.CertificateResponse {
+	if m.MockGenerateCertificate != nil {
+		return m.MockGenerateCertificate(opts)
+	}
+	return &models.CertificateResponse{}
+}
+
+func (m *MockGenerator) GeneratePassport(birthdate string) *models.PassportResponse {
+	if m.MockGeneratePassport != nil {
+		return m.MockGeneratePassport(birthdate)
+	}
+	return &models.PassportResponse{}
+}
+
+func (m *MockGenerator) GenerateCouncilRegistration(council, stateCode string) *models.CouncilRegistrationResponse {
+	if m.MockGenerateCouncilRegistration != nil {
+		return m.MockGenerateCouncilRegistration(council, stateCode)
+	}
+	return &models.CouncilRegistrationResponse{}
+}
+
 func (m *MockGenerator) GenerateEmail(customDomain string) (email, username, domain string) {
 	if m.MockGenerateEmail != nil {
 		return m.MockGenerateEmail(customDomain)
@@ -54,6 +97,13 @@ func (m *MockGenerator) GeneratePhone(stateCode, requestedType string) (phone, d
 	return "(11) 99999-9999", "11", "SP", "mobile"
 }
 
+func (m *MockGenerator) GeneratePhoneInCity(stateCode, city, requestedType string) (phone, ddd, state, phoneType string) {
+	if m.MockGeneratePhoneInCity != nil {
+		return m.MockGeneratePhoneInCity(stateCode, city, requestedType)
+	}
+	return "(11) 99999-9999", "11", "SP", "mobile"
+}
+
 func (m *MockGenerator) GeneratePerson(gender, stateCode string) *models.Person {
 	if m.MockGeneratePerson != nil {
 		return m.MockGeneratePerson(gender, stateCode)
@@ -61,6 +111,27 @@ func (m *MockGenerator) GeneratePerson(gender, stateCode string) *models.Person
 	return &models.Person{}
 }
 
+func (m *MockGenerator) GeneratePersonWithOptions(opts PersonOptions) *models.Person {
+	if m.MockGeneratePersonWithOpts != nil {
+		return m.MockGeneratePersonWithOpts(opts)
+	}
+	return &models.Person{}
+}
+
+func (m *MockGenerator) NormalizePersonOptions(opts PersonOptions) (PersonOptions, error) {
+	if m.MockNormalizePersonOpts != nil {
+		return m.MockNormalizePersonOpts(opts)
+	}
+	return opts, nil
+}
+
+func (m *MockGenerator) GenerateHousehold(opts HouseholdOptions) *models.Household {
+	if m.MockGenerateHousehold != nil {
+		return m.MockGenerateHousehold(opts)
+	}
+	return &models.Household{}
+}
+
 func (m *MockGenerator) GenerateAddress(stateCode, city string) *models.Address {
 	if m.MockGenerateAddress != nil {
 		return m.MockGenerateAddress(stateCode, city)
@@ -68,13 +139,90 @@ func (m *MockGenerator) GenerateAddress(stateCode, city string) *models.Address
 	return &models.Address{}
 }
 
-func (m *MockGenerator) GenerateZipcodeDetails(stateCode string) (formatted, unformatted, state, city string) {
+func (m *MockGenerator) GenerateZipcodeDetails(stateCode, cityName string) (formatted, unformatted, state, city string) {
 	if m.MockGenerateZipcodeDetails != nil {
-		return m.MockGenerateZipcodeDetails(stateCode)
+		return m.MockGenerateZipcodeDetails(stateCode, cityName)
 	}
 	return "00000-000", "00000000", "SP", "São Paulo"
 }
 
+func (m *MockGenerator) LookupZipcode(cep string) *models.ZipcodeLookupResponse {
+	if m.MockLookupZipcode != nil {
+		return m.MockLookupZipcode(cep)
+	}
+	return nil
+}
+
+func (m *MockGenerator) NormalizeLocationOptions(opts LocationOptions) (LocationOptions, error) {
+	if m.MockNormalizeLocationOpts != nil {
+		return m.MockNormalizeLocationOpts(opts)
+	}
+	return opts, nil
+}
+
+func (m *MockGenerator) PickLocation(opts LocationOptions) (stateCode, city string) {
+	if m.MockPickLocation != nil {
+		return m.MockPickLocation(opts)
+	}
+	return opts.State, opts.City
+}
+
+func (m *MockGenerator) FindNearbyAddresses(lat, lng, radiusKm float64, quantity int) *models.NearbyAddressesResponse {
+	if m.MockFindNearbyAddresses != nil {
+		return m.MockFindNearbyAddresses(lat, lng, radiusKm, quantity)
+	}
+	return &models.NearbyAddressesResponse{Lat: lat, Lng: lng, RadiusKm: radiusKm, Addresses: []models.NearbyAddressResponse{}}
+}
+
+func (m *MockGenerator) ReverseGeocode(lat, lng float64) *models.NearbyAddressResponse {
+	if m.MockReverseGeocode != nil {
+		return m.MockReverseGeocode(lat, lng)
+	}
+	return nil
+}
+
+func (m *MockGenerator) AutocompleteStreets(query, stateCode, city string, limit int) *models.AutocompleteResponse {
+	if m.MockAutocompleteStreets != nil {
+		return m.MockAutocompleteStreets(query, stateCode, city, limit)
+	}
+	return &models.AutocompleteResponse{Query: query, Suggestions: []models.StreetResponse{}}
+}
+
+func (m *MockGenerator) ValidateAddress(req models.AddressValidationRequest) *models.AddressValidationResponse {
+	if m.MockValidateAddress != nil {
+		return m.MockValidateAddress(req)
+	}
+	return &models.AddressValidationResponse{Valid: true, Findings: []models.AddressValidationFinding{}}
+}
+
+func (m *MockGenerator) ListStates() []models.StateResponse {
+	if m.MockListStates != nil {
+		return m.MockListStates()
+	}
+	return []models.StateResponse{}
+}
+
+func (m *MockGenerator) ListCities(stateCode string) ([]models.CityResponse, bool) {
+	if m.MockListCities != nil {
+		return m.MockListCities(stateCode)
+	}
+	return nil, false
+}
+
+func (m *MockGenerator) ListDistricts(stateCode, cityName string) ([]models.DistrictResponse, bool) {
+	if m.MockListDistricts != nil {
+		return m.MockListDistricts(stateCode, cityName)
+	}
+	return nil, false
+}
+
+func (m *MockGenerator) ListStreets(stateCode, cityName, districtName string, page, pageSize int) (*models.StreetPage, bool) {
+	if m.MockListStreets != nil {
+		return m.MockListStreets(stateCode, cityName, districtName, page, pageSize)
+	}
+	return nil, false
+}
+
 func (m *MockGenerator) GenerateBankAccount(bankCode string) (bank Bank, agency, account, accountType string) {
 	if m.MockGenerateBankAccount != nil {
 		return m.MockGenerateBankAccount(bankCode)
@@ -96,6 +244,34 @@ func (m *MockGenerator) GenerateCompany() *models.CompanyResponse {
 	return &models.CompanyResponse{}
 }
 
+func (m *MockGenerator) GenerateCompanyWithOptions(opts CompanyOptions) *models.CompanyResponse {
+	if m.MockGenerateCompanyWithOptions != nil {
+		return m.MockGenerateCompanyWithOptions(opts)
+	}
+	return &models.CompanyResponse{}
+}
+
+func (m *MockGenerator) GenerateCompanyGroup(opts CompanyOptions, branches int) *models.CompanyGroupResponse {
+	if m.MockGenerateCompanyGroup != nil {
+		return m.MockGenerateCompanyGroup(opts, branches)
+	}
+	return &models.CompanyGroupResponse{}
+}
+
+func (m *MockGenerator) GenerateMEI(stateCode, activity string) *models.MEIResponse {
+	if m.MockGenerateMEI != nil {
+		return m.MockGenerateMEI(stateCode, activity)
+	}
+	return &models.MEIResponse{}
+}
+
+func (m *MockGenerator) GenerateCompanyEmployees(opts CompanyOptions, cnpj string, count int) *models.CompanyEmployeesResponse {
+	if m.MockGenerateCompanyEmployees != nil {
+		return m.MockGenerateCompanyEmployees(opts, cnpj, count)
+	}
+	return &models.CompanyEmployeesResponse{}
+}
+
 func (m *MockGenerator) GetDataStore() *DataStore {
 	if m.MockGetDataStore != nil {
 		return m.MockGetDataStore()
@@ -0,0 +1,103 @@
+package generators
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// Brazilian passport data
+const (
+	// passportIssuer is the issuing authority of Brazilian passports (Departamento de Polícia Federal)
+	passportIssuer = "DPF"
+	// adultPassportYears and minorPassportYears are the validity of passports issued to adults and minors
+	adultPassportYears = 10
+	minorPassportYears = 5
+)
+
+// brazilianPassportRegex matches a Brazilian passport number: two letters and six digits
+var brazilianPassportRegex = regexp.MustCompile(`^[A-Z]{2}\d{6}$`)
+
+// GeneratePassport generates a valid Brazilian passport for a holder born at the given date
+// A random birthdate is used when it is empty or invalid
+func (g *Generator) GeneratePassport(birthdate string) *models.PassportResponse {
+	if _, err := time.Parse("2006-01-02", birthdate); err != nil {
+		birthdate = generateBirthdate(MinPersonAge, DefaultMaxAge)
+	}
+
+	passport := generateBrazilianPassport(birthdate)
+
+	return &models.PassportResponse{
+		Number:          passport.Number,
+		Country:         passport.Country,
+		Issuer:          passport.Issuer,
+		IssueDate:       passport.IssueDate,
+		ExpirationDate:  passport.ExpirationDate,
+		HolderBirthdate: birthdate,
+	}
+}
+
+// generateBrazilianPassport generates a Brazilian passport valid today for a holder born at the given date
+// Passports issued to minors are valid for 5 years and to adults for 10 years; expired ones are renewed
+func generateBrazilianPassport(birthdate string) models.PersonPassport {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	birth, err := time.Parse("2006-01-02", birthdate)
+	if err != nil {
+		birth = today.AddDate(-DefaultMinAge, 0, 0)
+	}
+
+	earliest := today.AddDate(-adultPassportYears, 0, 0)
+	if birth.After(earliest) {
+		earliest = birth
+	}
+	issue := earliest.AddDate(0, 0, rand.Intn(max(int(today.Sub(earliest).Hours()/24), 1)))
+	expiration := issue.AddDate(passportValidity(birth, issue), 0, 0)
+	for expiration.Before(today) {
+		issue = expiration
+		expiration = issue.AddDate(passportValidity(birth, issue), 0, 0)
+	}
+
+	return models.PersonPassport{
+		Number:         generateBrazilianPassportNumber(issue),
+		Country:        NationalityBrazilian,
+		Issuer:         passportIssuer,
+		IssueDate:      issue.Format("2006-01-02"),
+		ExpirationDate: expiration.Format("2006-01-02"),
+	}
+}
+
+// passportValidity returns the validity in years of a passport issued at the given date
+func passportValidity(birth, issue time.Time) int {
+	if issue.Before(birth.AddDate(AdultAge, 0, 0)) {
+		return minorPassportYears
+	}
+	return adultPassportYears
+}
+
+// generateBrazilianPassportNumber generates a passport number of the series in use at the issue date
+// The first letter of the series advances over the years (C until 2010, F until 2019, G since 2020)
+func generateBrazilianPassportNumber(issue time.Time) string {
+	series := byte('G')
+	switch {
+	case issue.Year() < 2011:
+		series = 'C'
+	case issue.Year() < 2020:
+		series = 'F'
+	}
+	return fmt.Sprintf("%c%c%06d", series, 'A'+rand.Intn(26), rand.Intn(1000000))
+}
+
+// CleanPassport removes spaces and dashes of a passport number and converts it to uppercase
+func CleanPassport(passport string) string {
+	clean := strings.NewReplacer(" ", "", "-", "", ".", "").Replace(passport)
+	return strings.ToUpper(clean)
+}
+
+// ValidatePassport checks if a Brazilian passport number has two letters followed by six digits
+func ValidatePassport(passport string) bool {
+	return brazilianPassportRegex.MatchString(CleanPassport(passport))
+}
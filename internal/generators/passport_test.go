@@ -0,0 +1,103 @@
+package generators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for Brazilian passports focusing on:
+// 1. Number format
+// 2. Validity of 5 years for minors and 10 years for adults
+// 3. Dates consistent with the holder's birthdate
+// 4. Validation
+// 5. Optional passport of persons
+
+func TestGenerateBrazilianPassport_Adult(t *testing.T) {
+	today := time.Now().Format("2006-01-02")
+
+	for i := 0; i < 100; i++ {
+		passport := generateBrazilianPassport("1980-02-15")
+
+		assert.True(t, ValidatePassport(passport.Number), passport.Number)
+		assert.Equal(t, "BR", passport.Country)
+		assert.Equal(t, "DPF", passport.Issuer)
+		assert.GreaterOrEqual(t, passport.ExpirationDate, today, "Passport should be valid")
+
+		issue, _ := time.Parse("2006-01-02", passport.IssueDate)
+		assert.Equal(t, issue.AddDate(10, 0, 0).Format("2006-01-02"), passport.ExpirationDate)
+	}
+}
+
+func TestGenerateBrazilianPassport_Minor(t *testing.T) {
+	birthdate := time.Now().AddDate(-8, 0, 0).Format("2006-01-02")
+
+	for i := 0; i < 100; i++ {
+		passport := generateBrazilianPassport(birthdate)
+
+		assert.GreaterOrEqual(t, passport.IssueDate, birthdate, "Passport should not be issued before the birth")
+		issue, _ := time.Parse("2006-01-02", passport.IssueDate)
+		assert.Equal(t, issue.AddDate(5, 0, 0).Format("2006-01-02"), passport.ExpirationDate)
+	}
+}
+
+func TestPassportValidity(t *testing.T) {
+	birth := time.Date(2000, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 5, passportValidity(birth, time.Date(2018, 5, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 10, passportValidity(birth, time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestGenerateBrazilianPassportNumber_Series(t *testing.T) {
+	assert.Regexp(t, `^C[A-Z]\d{6}$`, generateBrazilianPassportNumber(time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Regexp(t, `^F[A-Z]\d{6}$`, generateBrazilianPassportNumber(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Regexp(t, `^G[A-Z]\d{6}$`, generateBrazilianPassportNumber(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestValidatePassport(t *testing.T) {
+	tests := []struct {
+		passport string
+		valid    bool
+	}{
+		{"FZ123456", true},
+		{"fz 123456", true},
+		{"FZ-123456", true},
+		{"F1234567", false},
+		{"FZ12345", false},
+		{"FZA12345", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.valid, ValidatePassport(tt.passport), tt.passport)
+	}
+}
+
+func TestGeneratePassport(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	passport := gen.GeneratePassport("1990-07-07")
+	assert.Equal(t, "1990-07-07", passport.HolderBirthdate)
+	assert.True(t, ValidatePassport(passport.Number))
+
+	passport = gen.GeneratePassport("")
+	assert.NotEmpty(t, passport.HolderBirthdate, "A random birthdate is used when none is given")
+	assert.GreaterOrEqual(t, passport.IssueDate, passport.HolderBirthdate)
+}
+
+func TestGeneratePerson_Passport(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	person := gen.GeneratePersonWithOptions(PersonOptions{Passport: true})
+	if assert.NotNil(t, person.Passport) {
+		assert.GreaterOrEqual(t, person.Passport.IssueDate, person.Birthdate)
+	}
+
+	person = gen.GeneratePersonWithOptions(PersonOptions{})
+	assert.Nil(t, person.Passport)
+
+	person = gen.GeneratePersonWithOptions(PersonOptions{Passport: true, Nationality: "AR"})
+	assert.Nil(t, person.Passport, "Foreign nationals carry the passport of their country")
+	assert.Equal(t, "AR", person.ForeignResident.Passport.Country)
+}
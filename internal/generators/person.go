@@ -1,6 +1,7 @@
 package generators
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -10,14 +11,106 @@ import (
 	"github.com/diogomcd/fake-mill-api/internal/models"
 )
 
+// PersonOptions contains the constraints applied when generating a person
+// Zero values mean no constraint
+type PersonOptions struct {
+	// Gender is male, female or non-binary, random when empty
+	Gender string
+	State  string
+	// City restricts the address to a city, ignoring case and accents; it must exist in the state when both are given
+	City string
+	// Region restricts the address to a region (ex: Nordeste), ignoring case and accents
+	Region string
+	// Capital restricts the address to state capitals (true) or to the other cities (false)
+	Capital *bool
+	// MinAge and MaxAge default to adults (18 to 80) when both are zero
+	MinAge    int
+	MaxAge    int
+	MinSalary float64
+	MaxSalary float64
+	// ProfessionArea restricts the profession to an area (ex: Tecnologia)
+	ProfessionArea string
+	Education      string
+	MaritalStatus  string
+	BloodType      string
+	// BirthState is the state where the person was born, the residence state when empty
+	BirthState string
+	// HasCompany forces the presence (true) or absence (false) of a company, always present when nil
+	HasCompany *bool
+	// DiversityRate is the share (0 to 1) of adults whose gender differs from the sex at birth,
+	// who also get a social name
+	DiversityRate float64
+	// Persona adds credit card, bank account and social handles derived from the person
+	Persona bool
+	// Passport adds a Brazilian passport, ignored for foreign nationals who always carry their own
+	Passport bool
+	// Nationality is BR, foreign (random country) or the code of a country, Brazilian when empty
+	Nationality string
+	// Distribution selects how names are sampled, realistic when empty
+	Distribution NameDistribution
+}
+
+// NameDistribution defines how names and surnames are sampled
+type NameDistribution string
+
+// Name distributions
+const (
+	// NameDistributionRealistic follows the frequency of names in the population
+	NameDistributionRealistic NameDistribution = "realistic"
+	// NameDistributionUniform gives every name the same chance
+	NameDistributionUniform NameDistribution = "uniform"
+)
+
+// Age bounds of generated persons
+const (
+	// DefaultMinAge and DefaultMaxAge are used when no age constraint is given
+	DefaultMinAge = 18
+	DefaultMaxAge = 80
+	// MinPersonAge and MaxPersonAge are the limits accepted for age constraints
+	MinPersonAge = 0
+	MaxPersonAge = 100
+	// AdultAge is the age of civil majority, younger persons are minors
+	AdultAge = 18
+)
+
+// personSeed carries values shared with other persons, such as relatives
+// Empty fields are generated as usual
+type personSeed struct {
+	firstNames    []string
+	lastNames     []string
+	filiation     *models.Filiation
+	address       *models.Address
+	maritalStatus string
+}
+
 // GeneratePerson generates complete fake person data
 func (g *Generator) GeneratePerson(gender, stateCode string) *models.Person {
-	ds := g.dataStore
+	return g.GeneratePersonWithOptions(PersonOptions{
+		Gender: gender,
+		State:  stateCode,
+	})
+}
 
-	if gender == "" || (gender != "male" && gender != "female") {
-		genders := []string{"male", "female"}
-		gender = genders[rand.Intn(len(genders))]
+// GeneratePersonWithOptions generates complete fake person data following the given constraints
+// Constraints are normalized first; invalid constraints are dropped one at a time and the others are kept,
+// use NormalizePersonOptions to reject them
+func (g *Generator) GeneratePersonWithOptions(opts PersonOptions) *models.Person {
+	normalized, err := g.NormalizePersonOptions(opts)
+	for err != nil {
+		var optionErr *PersonOptionError
+		if !errors.As(err, &optionErr) || !dropPersonOption(&opts, optionErr.Parameter) {
+			normalized, _ = g.NormalizePersonOptions(PersonOptions{})
+			break
+		}
+		normalized, err = g.NormalizePersonOptions(opts)
 	}
+	return g.buildPerson(normalized, personSeed{})
+}
+
+// buildPerson generates a person following the constraints and reusing the seeded values
+func (g *Generator) buildPerson(opts PersonOptions, seed personSeed) *models.Person {
+	ds := g.dataStore
+	stateCode, city := g.PickLocation(LocationOptions{State: opts.State, City: opts.City, Region: opts.Region, Capital: opts.Capital})
 
 	// Choose consistent state for RG, address and phone
 	var selectedState *StateData
@@ -29,34 +122,126 @@ func (g *Generator) GeneratePerson(gender, stateCode string) *models.Person {
 	}
 	actualStateCode := selectedState.Code
 
-	personName := g.generatePersonName(gender)
-	filiation := g.generateFiliation()
-	cpf := g.generatePersonCPF()
-	rg := g.generatePersonRG(actualStateCode)
-	birthdate := generateBirthdate()
+	minAge, maxAge := opts.MinAge, opts.MaxAge
+	if minAge == 0 && maxAge == 0 {
+		minAge, maxAge = DefaultMinAge, DefaultMaxAge
+	}
+
+	// The birthdate comes first so names follow the fashion of the birth decade
+	birthdate := generateBirthdate(minAge, maxAge)
 	age := calculateAge(birthdate)
-	height := generateHeight(gender)
-	weight := generateWeight(gender)
+	minor := isMinor(age)
+	profile := g.newNameProfile(opts.Distribution, actualStateCode, birthdate)
+
+	// Minors keep the sex at birth as gender, the diversity rate only applies to adults
+	diversityRate := opts.DiversityRate
+	if minor {
+		diversityRate = 0
+	}
+	identity := generateGenderIdentity(opts.Gender, diversityRate)
+
+	// Foreign nationals get names of their country, an RNM instead of an RG and were born abroad
+	country := g.resolveCountry(opts.Nationality)
+
+	// The civil name is registered at birth, so it follows the sex at birth
+	var personName, father, mother models.PersonName
+	if country != nil {
+		personName, father, mother = generateForeignName(country, identity.sexAtBirth)
+	} else {
+		firstNames := seed.firstNames
+		if len(firstNames) == 0 {
+			firstNames = g.generateFirstNames(identity.sexAtBirth, profile)
+		}
+		lastNames := seed.lastNames
+		if len(lastNames) == 0 {
+			lastNames = g.generateLastNames(2+rand.Intn(2), profile) // 2 or 3 last names
+		}
+		personName = composePersonName(firstNames, lastNames)
+		if seed.filiation == nil {
+			father, mother = g.generateParentNames(lastNames, profile)
+		}
+	}
+	socialName := g.generateSocialName(identity, personName, profile)
+
+	filiation := models.Filiation{
+		Father: father.FullName,
+		Mother: mother.FullName,
+	}
+	if seed.filiation != nil {
+		filiation = *seed.filiation
+	}
+
+	cpf := g.generatePersonCPF()
+	var rg *models.PersonRG
+	if country == nil && (!minor || minorHasRG(age)) {
+		rg = g.generatePersonRG(actualStateCode, birthdate)
+	}
+	height := generateHeight(identity.sexAtBirth, age)
+	weight := generateWeight(identity.sexAtBirth, age)
 	bmi := calculateBMI(weight.Kilograms, height.Meters)
 	zodiacSign := ds.GetZodiacSign(birthdate)
 	favoriteColor := ds.GetRandomColor()
-	bloodType := ds.GetRandomBloodType()
-	email := g.generatePersonEmail(personName.FirstName, personName.LastName)
-	phone := g.generatePersonPhone(actualStateCode)
-	address := g.GenerateAddress(actualStateCode, "")
-	profession := g.generatePersonProfession()
-	company := g.generatePersonCompany()
-	education := ds.GetRandomEducationLevel()
-	maritalStatus := ds.GetRandomMaritalStatus()
-	birthCity := ds.GetRandomCity(actualStateCode)
-
-	return &models.Person{
+	bloodType := opts.BloodType
+	if bloodType == "" {
+		bloodType = ds.GetRandomBloodType()
+	}
+	displayName := personName
+	if socialName != nil {
+		displayName = *socialName
+	}
+	email := g.generatePersonEmail(displayName, birthdate)
+	phone := g.generatePersonPhone(actualStateCode, city)
+	address := seed.address
+	if address == nil {
+		address = g.GenerateAddress(actualStateCode, city)
+	}
+	education := opts.Education
+	if education == "" && minor {
+		education = ds.GetMinorEducationLevel(age)
+	}
+	if education == "" {
+		education = ds.GetRandomEducationLevelForAge(age)
+	}
+
+	// Minors have no profession, company nor marital status
+	var profession *models.PersonProfession
+	var company *models.PersonCompany
+	var maritalStatus string
+	if !minor {
+		profession = g.generatePersonProfession(education, age, opts.ProfessionArea, opts.MinSalary, opts.MaxSalary, actualStateCode)
+		if opts.HasCompany == nil || *opts.HasCompany {
+			company = g.generatePersonCompany()
+		}
+		maritalStatus = seed.maritalStatus
+		if maritalStatus == "" {
+			maritalStatus = opts.MaritalStatus
+		}
+		if maritalStatus == "" {
+			maritalStatus = ds.GetRandomMaritalStatusForAge(age)
+		}
+	}
+	nationality, birthState, birthCity := NationalityBrazilian, opts.BirthState, ""
+	if country != nil {
+		nationality = country.Code
+		birthState = foreignBirthState
+		birthCity = country.Cities[rand.Intn(len(country.Cities))]
+	} else {
+		if birthState == "" {
+			birthState = actualStateCode
+		}
+		birthCity = ds.GetRandomCity(birthState)
+	}
+
+	person := &models.Person{
 		Name:          personName,
+		SocialName:    socialName,
 		CPF:           cpf,
 		RG:            rg,
 		Birthdate:     birthdate,
 		Age:           age,
-		Gender:        gender,
+		Gender:        identity.gender,
+		SexAtBirth:    identity.sexAtBirth,
+		Pronouns:      identity.pronouns(),
 		Height:        height,
 		Weight:        weight,
 		BMI:           bmi,
@@ -72,85 +257,197 @@ func (g *Generator) GeneratePerson(gender, stateCode string) *models.Person {
 		Education:     education,
 		MaritalStatus: maritalStatus,
 		BirthCity:     birthCity,
+		BirthState:    birthState,
+		Nationality:   nationality,
+	}
+
+	if country != nil {
+		person.ForeignResident = generateForeignResident(country, birthdate)
+	} else if opts.Passport {
+		passport := generateBrazilianPassport(birthdate)
+		person.Passport = &passport
 	}
+
+	if minor {
+		person.BirthCertificate = g.generatePersonBirthCertificate(birthState, birthdate)
+		// Minors generated with a seeded filiation already have their parents around (households)
+		if seed.filiation == nil {
+			person.Guardian = g.generateGuardian(father, mother, age, actualStateCode, address, opts.Distribution)
+		}
+	}
+
+	if opts.Persona {
+		g.attachPersona(person)
+	}
+
+	return person
 }
 
-// generatePersonProfession generates professional information for the person
-func (g *Generator) generatePersonProfession() models.PersonProfession {
-	profession := g.dataStore.GetRandomProfession()
+// resolveCountry returns the country of a foreign nationality, or nil for Brazilian nationals
+func (g *Generator) resolveCountry(nationality string) *CountryData {
+	switch nationality {
+	case "", NationalityBrazilian:
+		return nil
+	case NationalityForeign:
+		return g.dataStore.GetRandomCountry()
+	}
+	return g.dataStore.GetCountryByCode(nationality)
+}
 
-	return models.PersonProfession{
-		Title: profession.Title,
-		Area:  profession.Area,
+// generatePersonProfession generates professional information for the person
+// The salary takes education and age into account and respects the requested area and range
+// Regulated professions carry a council registration in the state where the person lives
+func (g *Generator) generatePersonProfession(education string, age int, area string, minSalary, maxSalary float64, stateCode string) *models.PersonProfession {
+	profession, salaryRange := g.pickProfessionForSalary(education, age, area, minSalary, maxSalary)
+	salary := generateSalary(salaryRange, g.dataStore.GetMinimumWage())
+
+	return &models.PersonProfession{
+		Title:        profession.Title,
+		Area:         profession.Area,
+		Salary:       FormatBRL(salary),
+		SalaryValue:  salary,
+		Registration: generateCouncilRegistration(profession, stateCode),
 	}
 }
 
 // generatePersonCompany generates company information for the person
-func (g *Generator) generatePersonCompany() models.PersonCompany {
+func (g *Generator) generatePersonCompany() *models.PersonCompany {
 	company := g.GenerateCompany()
 
-	return models.PersonCompany{
+	return &models.PersonCompany{
 		Name: company.Name,
 		CNPJ: company.CNPJ,
 	}
 }
 
-// generatePersonName generates full name of the person
-func (g *Generator) generatePersonName(gender string) models.PersonName {
-	ds := g.dataStore
+// nameProfile describes the population a name is drawn from
+// The zero value samples realistic names over the whole country and all ages
+type nameProfile struct {
+	distribution NameDistribution
+	region       string
+	birthYear    int
+}
+
+// Typical age of the parents at the birth of a child, used to date the names of the filiation
+const parentAgeAtBirth = 28
+
+// newNameProfile builds the name profile of a person born in the state at the given date
+func (g *Generator) newNameProfile(distribution NameDistribution, stateCode, birthdate string) nameProfile {
+	profile := nameProfile{distribution: distribution}
+
+	if state := g.dataStore.GetStateByCode(stateCode); state != nil {
+		profile.region = state.Region
+	}
+	if birth, err := time.Parse("2006-01-02", birthdate); err == nil {
+		profile.birthYear = birth.Year()
+	}
+
+	return profile
+}
+
+// parents returns the name profile of the parents of a person with this profile
+func (p nameProfile) parents() nameProfile {
+	if p.birthYear > 0 {
+		p.birthYear -= parentAgeAtBirth
+	}
+	return p
+}
 
-	// Generate first names (1 or 2 with 20% chance)
-	var firstNames []string
+// generateFirstNames generates the first names of the person (1 or 2 with 20% chance)
+func (g *Generator) generateFirstNames(gender string, profile nameProfile) []string {
 	firstNameCount := 1
 	if rand.Intn(100) < 20 { // 20% chance of having 2 first names
 		firstNameCount = 2
 	}
 
+	firstNames := make([]string, 0, firstNameCount)
 	for i := 0; i < firstNameCount; i++ {
+		firstNames = append(firstNames, g.pickFirstName(gender, profile))
+	}
+
+	return firstNames
+}
+
+// generateLastNames generates the given number of last names
+func (g *Generator) generateLastNames(count int, profile nameProfile) []string {
+	lastNames := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		lastNames = append(lastNames, g.pickLastName(profile))
+	}
+	return lastNames
+}
+
+// pickFirstName picks a single first name of the given gender
+func (g *Generator) pickFirstName(gender string, profile nameProfile) string {
+	ds := g.dataStore
+
+	if profile.distribution == NameDistributionUniform {
 		if gender == "male" {
-			firstNames = append(firstNames, ds.GetRandomMaleFirstName())
-		} else {
-			firstNames = append(firstNames, ds.GetRandomFemaleFirstName())
+			return ds.GetRandomMaleFirstName()
 		}
+		return ds.GetRandomFemaleFirstName()
 	}
 
-	// Generate last names (2 or 3)
-	lastNameCount := 2 + rand.Intn(2) // 2 or 3 last names
-	var lastNames []string
-	for i := 0; i < lastNameCount; i++ {
-		lastNames = append(lastNames, ds.GetRandomLastName())
+	return ds.GetWeightedFirstName(gender, profile.region, profile.birthYear)
+}
+
+// pickLastName picks a single last name
+func (g *Generator) pickLastName(profile nameProfile) string {
+	if profile.distribution == NameDistributionUniform {
+		return g.dataStore.GetRandomLastName()
 	}
+	return g.dataStore.GetWeightedLastName(profile.region)
+}
+
+// generatePersonName generates full name of the person
+func (g *Generator) generatePersonName(gender string, profile nameProfile) models.PersonName {
+	return composePersonName(g.generateFirstNames(gender, profile), g.generateLastNames(2+rand.Intn(2), profile))
+}
 
-	// Combine first names and last names
+// composePersonName combines first names and last names
+func composePersonName(firstNames, lastNames []string) models.PersonName {
 	firstName := strings.Join(firstNames, " ")
 	lastName := strings.Join(lastNames, " ")
-	fullName := firstName + " " + lastName
 
 	return models.PersonName{
 		FirstName: firstName,
 		LastName:  lastName,
-		FullName:  fullName,
+		FullName:  firstName + " " + lastName,
 	}
 }
 
-// generateFiliation generates family information (father and mother)
-func (g *Generator) generateFiliation() models.Filiation {
-	ds := g.dataStore
+// generateFiliation generates family information (father and mother) consistent with the person's last names
+func (g *Generator) generateFiliation(lastNames []string, profile nameProfile) models.Filiation {
+	father, mother := g.generateParentNames(lastNames, profile)
+
+	return models.Filiation{
+		Father: father.FullName,
+		Mother: mother.FullName,
+	}
+}
 
-	// Father: male name + 2 last names
-	fatherFirstName := ds.GetRandomMaleFirstName()
-	fatherLastNames := []string{ds.GetRandomLastName(), ds.GetRandomLastName()}
-	father := fatherFirstName + " " + strings.Join(fatherLastNames, " ")
+// generateParentNames generates the names of the father and the mother consistent with the person's last names
+// Following the Brazilian convention, the last surname comes from the father and the previous ones from the mother
+func (g *Generator) generateParentNames(lastNames []string, profile nameProfile) (father, mother models.PersonName) {
+	parents := profile.parents()
 
-	// Mother: female name + 2 last names
-	motherFirstName := ds.GetRandomFemaleFirstName()
-	motherLastNames := []string{ds.GetRandomLastName(), ds.GetRandomLastName()}
-	mother := motherFirstName + " " + strings.Join(motherLastNames, " ")
+	paternal := lastNames[len(lastNames)-1]
+	maternal := lastNames[:len(lastNames)-1]
+	if len(maternal) == 0 {
+		maternal = []string{g.pickLastName(parents)}
+	}
 
-	return models.Filiation{
-		Father: father,
-		Mother: mother,
+	// Father: male name + own maternal last name + surname passed to the person
+	father = composePersonName([]string{g.pickFirstName("male", parents)}, []string{g.pickLastName(parents), paternal})
+
+	// Mother: female name + surnames passed to the person, completed to 2 last names
+	motherLastNames := append([]string{}, maternal...)
+	if len(motherLastNames) < 2 {
+		motherLastNames = append(motherLastNames, g.pickLastName(parents))
 	}
+	mother = composePersonName([]string{g.pickFirstName("female", parents)}, motherLastNames)
+
+	return father, mother
 }
 
 // generatePersonCPF generates CPF as object
@@ -165,11 +462,19 @@ func (g *Generator) generatePersonCPF() models.PersonCPF {
 }
 
 // generatePersonRG generates RG as object
-func (g *Generator) generatePersonRG(stateCode string) models.PersonRG {
+// The issue date is never before the birthdate
+func (g *Generator) generatePersonRG(stateCode, birthdate string) *models.PersonRG {
 	rgUnmasked, state, issuer, issueDate, expirationDate := g.GenerateRG(stateCode, false, true)
 	rgMasked := FormatRG(rgUnmasked)
 
-	return models.PersonRG{
+	if birth, err := time.Parse("2006-01-02", birthdate); err == nil && issueDate < birthdate {
+		days := int(time.Since(birth).Hours() / 24)
+		issue := birth.AddDate(0, 0, rand.Intn(max(days, 1)))
+		issueDate = issue.Format("2006-01-02")
+		expirationDate = issue.AddDate(10, 0, 0).Format("2006-01-02")
+	}
+
+	return &models.PersonRG{
 		Masked:         rgMasked,
 		Unmasked:       rgUnmasked,
 		State:          state,
@@ -180,9 +485,12 @@ func (g *Generator) generatePersonRG(stateCode string) models.PersonRG {
 }
 
 // generateHeight generates height in different metrics
-func generateHeight(gender string) models.Height {
+// Minors follow the median growth of their age
+func generateHeight(gender string, age int) models.Height {
 	var heightCm float64
-	if gender == "male" {
+	if isMinor(age) {
+		heightCm = generateChildHeightCm(gender, age)
+	} else if gender == "male" {
 		// Men: average 175cm, deviation of 7cm
 		heightCm = 175 + (rand.NormFloat64() * 7)
 	} else {
@@ -191,8 +499,10 @@ func generateHeight(gender string) models.Height {
 	}
 
 	// Limit reasonable values
-	if heightCm < 140 {
+	if heightCm < 140 && !isMinor(age) {
 		heightCm = 140
+	} else if heightCm < 45 {
+		heightCm = 45
 	} else if heightCm > 200 {
 		heightCm = 200
 	}
@@ -210,21 +520,27 @@ func generateHeight(gender string) models.Height {
 }
 
 // generateWeight generates weight based on gender with realistic variation
-func generateWeight(gender string) models.Weight {
-	var minWeight, maxWeight float64
-
-	if gender == "male" {
-		minWeight = 60
-		maxWeight = 100
+// Minors follow the median weight of their age
+func generateWeight(gender string, age int) models.Weight {
+	var weightKg float64
+	if isMinor(age) {
+		weightKg = generateChildWeightKg(gender, age)
 	} else {
-		minWeight = 50
-		maxWeight = 85
-	}
+		var minWeight, maxWeight float64
 
-	// Generate weight with more concentrated distribution in the middle
-	weightKg := minWeight + (maxWeight-minWeight)*rand.Float64()
-	// Add small Gaussian variation for more realism
-	weightKg += rand.NormFloat64() * 5
+		if gender == "male" {
+			minWeight = 60
+			maxWeight = 100
+		} else {
+			minWeight = 50
+			maxWeight = 85
+		}
+
+		// Generate weight with more concentrated distribution in the middle
+		weightKg = minWeight + (maxWeight-minWeight)*rand.Float64()
+		// Add small Gaussian variation for more realism
+		weightKg += rand.NormFloat64() * 5
+	}
 
 	weightPounds := weightKg * 2.20462
 	weightGrams := weightKg * 1000
@@ -236,10 +552,15 @@ func generateWeight(gender string) models.Weight {
 	}
 }
 
-// generatePersonEmail generates email related to name with password
-func (g *Generator) generatePersonEmail(firstName, lastName string) models.PersonEmail {
-	// Generate email using the centralized logic that now uses dynamic domains
-	email, _, _ := g.GenerateEmail("")
+// generatePersonEmail generates email derived from the person's name and birth year with password
+func (g *Generator) generatePersonEmail(name models.PersonName, birthdate string) models.PersonEmail {
+	birthYear := 0
+	if t, err := time.Parse("2006-01-02", birthdate); err == nil {
+		birthYear = t.Year()
+	}
+
+	username := buildEmailUsername(name.FirstName, name.LastName, birthYear)
+	email := fmt.Sprintf("%s@%s", username, g.generateRandomDomain())
 
 	// Generate random password
 	password := generatePassword()
@@ -271,8 +592,8 @@ func generatePassword() string {
 }
 
 // generatePersonPhone generates phone as object
-func (g *Generator) generatePersonPhone(stateCode string) models.PersonPhone {
-	formatted, _, _, _ := g.GeneratePhone(stateCode, "")
+func (g *Generator) generatePersonPhone(stateCode, city string) models.PersonPhone {
+	formatted, _, _, _ := g.GeneratePhoneInCity(stateCode, city, "")
 
 	// For temporary purposes, let's assume Brazil
 	countryCode := "BR"
@@ -297,18 +618,22 @@ func (g *Generator) generatePersonPhone(stateCode string) models.PersonPhone {
 	}
 }
 
-// generateBirthdate generates a random birthdate
-func generateBirthdate() string {
-	minAge := 18
-	maxAge := 80
-	age := minAge + rand.Intn(maxAge-minAge+1)
-
+// generateBirthdate generates a random birthdate for an age between minAge and maxAge (inclusive)
+// Inverted bounds are swapped
+func generateBirthdate(minAge, maxAge int) string {
+	if minAge > maxAge {
+		minAge, maxAge = maxAge, minAge
+	}
 	now := time.Now()
-	birthYear := now.Year() - age
-	birthMonth := 1 + rand.Intn(12)
-	birthDay := 1 + rand.Intn(28) // Simplified, uses 28 to avoid problems with days of the month
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Latest birthdate: exactly minAge years ago
+	// Earliest birthdate: one day after maxAge+1 years ago
+	latest := today.AddDate(-minAge, 0, 0)
+	earliest := today.AddDate(-(maxAge + 1), 0, 1)
 
-	return time.Date(birthYear, time.Month(birthMonth), birthDay, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+	days := int(latest.Sub(earliest).Hours()/24) + 1
+	return earliest.AddDate(0, 0, rand.Intn(days)).Format("2006-01-02")
 }
 
 // calculateAge calculates the age based on the birthdate
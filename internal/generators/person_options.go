@@ -0,0 +1,233 @@
+package generators
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PersonOptionError reports an invalid person constraint
+type PersonOptionError struct {
+	Parameter string
+	Message   string
+}
+
+// Error implements the error interface
+func (e *PersonOptionError) Error() string {
+	return e.Message
+}
+
+// newPersonOptionError creates a PersonOptionError with a formatted message
+func newPersonOptionError(parameter, format string, args ...interface{}) *PersonOptionError {
+	return &PersonOptionError{
+		Parameter: parameter,
+		Message:   fmt.Sprintf(format, args...),
+	}
+}
+
+// NormalizePersonOptions validates the constraints and resolves them to canonical values
+// Age bounds are filled with the defaults (adults) when both are zero, and raised to the minimum
+// age required by the other constraints, so generation never needs to reject a person
+func (g *Generator) NormalizePersonOptions(opts PersonOptions) (PersonOptions, error) {
+	ds := g.dataStore
+
+	if opts.MinAge == 0 && opts.MaxAge == 0 {
+		opts.MinAge, opts.MaxAge = DefaultMinAge, DefaultMaxAge
+	}
+	if opts.MaxAge == 0 {
+		opts.MaxAge = max(DefaultMaxAge, opts.MinAge)
+	}
+	if opts.MinAge < MinPersonAge || opts.MinAge > MaxPersonAge {
+		return opts, newPersonOptionError("min_age", "min_age must be between %d and %d", MinPersonAge, MaxPersonAge)
+	}
+	if opts.MaxAge < MinPersonAge || opts.MaxAge > MaxPersonAge {
+		return opts, newPersonOptionError("max_age", "max_age must be between %d and %d", MinPersonAge, MaxPersonAge)
+	}
+	if opts.MinAge > opts.MaxAge {
+		return opts, newPersonOptionError("min_age", "min_age must be less than or equal to max_age")
+	}
+
+	if opts.DiversityRate < 0 || opts.DiversityRate > 1 {
+		return opts, newPersonOptionError("diversity_rate", "diversity_rate must be between 0 and 1")
+	}
+
+	// Minors keep the sex at birth as gender
+	if opts.Gender == GenderNonBinary {
+		if opts.MaxAge < AdultAge {
+			return opts, newPersonOptionError("gender", "gender %s is only available for adults (%d+)", GenderNonBinary, AdultAge)
+		}
+		opts.MinAge = max(opts.MinAge, AdultAge)
+	}
+
+	if opts.Education != "" {
+		education, ok := matchOption(opts.Education, ds.GetEducationLevels())
+		if !ok {
+			return opts, newPersonOptionError("education", "education must be one of: %s", strings.Join(ds.GetEducationLevels(), ", "))
+		}
+		minAge := ds.GetEducationMinAge(education)
+		if minAge > opts.MaxAge {
+			return opts, newPersonOptionError("education", "education %s requires a minimum age of %d", education, minAge)
+		}
+		opts.Education = education
+		opts.MinAge = max(opts.MinAge, minAge)
+	}
+
+	if opts.MaritalStatus != "" {
+		status, ok := matchOption(opts.MaritalStatus, ds.GetMaritalStatuses())
+		if !ok {
+			return opts, newPersonOptionError("marital_status", "marital_status must be one of: %s", strings.Join(ds.GetMaritalStatuses(), ", "))
+		}
+		// Minors have no marital status
+		minAge := max(AdultAge, ds.GetMaritalStatusMinAge(status))
+		if minAge > opts.MaxAge {
+			return opts, newPersonOptionError("marital_status", "marital_status %s requires a minimum age of %d", status, minAge)
+		}
+		opts.MaritalStatus = status
+		opts.MinAge = max(opts.MinAge, minAge)
+	}
+
+	if opts.BloodType != "" {
+		bloodType, ok := matchOption(opts.BloodType, ds.GetBloodTypes())
+		if !ok {
+			return opts, newPersonOptionError("blood_type", "blood_type must be one of: %s", strings.Join(ds.GetBloodTypes(), ", "))
+		}
+		opts.BloodType = bloodType
+	}
+
+	if opts.ProfessionArea != "" {
+		area, ok := matchOption(opts.ProfessionArea, ds.GetProfessionAreas())
+		if !ok {
+			return opts, newPersonOptionError("profession_area", "profession_area must be one of: %s", strings.Join(ds.GetProfessionAreas(), ", "))
+		}
+		opts.ProfessionArea = area
+		if err := requireAdult(&opts, "profession_area"); err != nil {
+			return opts, err
+		}
+	}
+
+	if opts.MinSalary > 0 || opts.MaxSalary > 0 {
+		if err := requireAdult(&opts, "salary"); err != nil {
+			return opts, err
+		}
+	}
+
+	if opts.HasCompany != nil && *opts.HasCompany {
+		if err := requireAdult(&opts, "has_company"); err != nil {
+			return opts, err
+		}
+	}
+
+	if opts.Nationality != "" {
+		nationality, err := normalizeNationality(ds, opts.Nationality)
+		if err != nil {
+			return opts, err
+		}
+		opts.Nationality = nationality
+	}
+	if opts.Nationality != "" && opts.Nationality != NationalityBrazilian {
+		if opts.BirthState != "" {
+			return opts, newPersonOptionError("birth_state", "birth_state is only available for Brazilian nationals")
+		}
+		if err := requireAdult(&opts, "nationality"); err != nil {
+			return opts, err
+		}
+	}
+
+	location, err := g.NormalizeLocationOptions(LocationOptions{State: opts.State, City: opts.City, Region: opts.Region, Capital: opts.Capital})
+	if err != nil {
+		var locationErr *LocationOptionError
+		if errors.As(err, &locationErr) {
+			return opts, newPersonOptionError(locationErr.Parameter, "%s", locationErr.Message)
+		}
+		return opts, err
+	}
+	opts.State, opts.City, opts.Region = location.State, location.City, location.Region
+
+	if opts.BirthState != "" {
+		state := ds.GetStateByCode(opts.BirthState)
+		if state == nil {
+			return opts, newPersonOptionError("birth_state", "birth_state must be a valid state code (ex: SP, RJ)")
+		}
+		opts.BirthState = state.Code
+	}
+
+	return opts, nil
+}
+
+// dropPersonOption clears the constraint named by the parameter of a PersonOptionError
+// The age bounds constrain each other, so both are cleared together
+// Returns false when the parameter is unknown or the constraint is already empty
+func dropPersonOption(opts *PersonOptions, parameter string) bool {
+	before := *opts
+	switch parameter {
+	case "min_age", "max_age":
+		opts.MinAge, opts.MaxAge = 0, 0
+	case "diversity_rate":
+		opts.DiversityRate = 0
+	case "gender":
+		opts.Gender = ""
+	case "education":
+		opts.Education = ""
+	case "marital_status":
+		opts.MaritalStatus = ""
+	case "blood_type":
+		opts.BloodType = ""
+	case "profession_area":
+		opts.ProfessionArea = ""
+	case "salary":
+		opts.MinSalary, opts.MaxSalary = 0, 0
+	case "has_company":
+		opts.HasCompany = nil
+	case "nationality":
+		opts.Nationality = ""
+	case "birth_state":
+		opts.BirthState = ""
+	case "state":
+		opts.State = ""
+	case "city":
+		opts.City = ""
+	case "region":
+		opts.Region = ""
+	case "capital":
+		opts.Capital = nil
+	default:
+		return false
+	}
+	return *opts != before
+}
+
+// normalizeNationality resolves a nationality to BR, foreign or the canonical code of a known country
+func normalizeNationality(ds *DataStore, nationality string) (string, error) {
+	value := strings.TrimSpace(nationality)
+	switch {
+	case strings.EqualFold(value, NationalityBrazilian):
+		return NationalityBrazilian, nil
+	case strings.EqualFold(value, NationalityForeign):
+		return NationalityForeign, nil
+	}
+
+	if country := ds.GetCountryByCode(value); country != nil {
+		return country.Code, nil
+	}
+	return "", newPersonOptionError("nationality", "nationality must be BR, foreign or one of: %s", strings.Join(ds.GetCountryCodes(), ", "))
+}
+
+// requireAdult raises the minimum age to the age of majority for constraints that only apply to adults
+func requireAdult(opts *PersonOptions, parameter string) error {
+	if opts.MaxAge < AdultAge {
+		return newPersonOptionError(parameter, "%s is only available for adults (%d+)", parameter, AdultAge)
+	}
+	opts.MinAge = max(opts.MinAge, AdultAge)
+	return nil
+}
+
+// matchOption finds the canonical option matching the value, ignoring case and accents
+func matchOption(value string, options []string) (string, bool) {
+	normalized := strings.ToLower(removeAccents(strings.TrimSpace(value)))
+	for _, option := range options {
+		if strings.ToLower(removeAccents(option)) == normalized {
+			return option, true
+		}
+	}
+	return "", false
+}
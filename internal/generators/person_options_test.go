@@ -0,0 +1,204 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for person constraints focusing on:
+// 1. Normalization of values and age bounds
+// 2. Rejection of invalid combinations
+// 3. Constraints applied during generation
+
+func newTestGenerator(t *testing.T) *Generator {
+	t.Helper()
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	return NewGenerator(ds)
+}
+
+func TestNormalizePersonOptions_Defaults(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	opts, err := gen.NormalizePersonOptions(PersonOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultMinAge, opts.MinAge)
+	assert.Equal(t, DefaultMaxAge, opts.MaxAge)
+}
+
+func TestNormalizePersonOptions_CanonicalValues(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	opts, err := gen.NormalizePersonOptions(PersonOptions{
+		Education:      "ensino medio completo",
+		MaritalStatus:  "Married",
+		BloodType:      "ab-",
+		ProfessionArea: "saude",
+		BirthState:     "ba",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ensino Médio Completo", opts.Education)
+	assert.Equal(t, "married", opts.MaritalStatus)
+	assert.Equal(t, "AB-", opts.BloodType)
+	assert.Equal(t, "Saúde", opts.ProfessionArea)
+	assert.Equal(t, "BA", opts.BirthState)
+}
+
+func TestNormalizePersonOptions_EducationRaisesMinAge(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	opts, err := gen.NormalizePersonOptions(PersonOptions{Education: "Doutorado"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, gen.dataStore.GetEducationMinAge("Doutorado"), opts.MinAge)
+}
+
+func TestNormalizePersonOptions_Invalid(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	tests := []struct {
+		name      string
+		opts      PersonOptions
+		parameter string
+	}{
+		{"Inverted ages", PersonOptions{MinAge: 50, MaxAge: 30}, "min_age"},
+		{"Age above limit", PersonOptions{MaxAge: 130}, "max_age"},
+		{"Education too advanced for age", PersonOptions{MaxAge: 22, Education: "Doutorado"}, "education"},
+		{"Unknown education", PersonOptions{Education: "Pós-Doutorado"}, "education"},
+		{"Unknown marital status", PersonOptions{MaritalStatus: "engaged"}, "marital_status"},
+		{"Unknown blood type", PersonOptions{BloodType: "C+"}, "blood_type"},
+		{"Unknown profession area", PersonOptions{ProfessionArea: "Astronáutica"}, "profession_area"},
+		{"Unknown birth state", PersonOptions{BirthState: "XX"}, "birth_state"},
+		{"Non-binary minor", PersonOptions{Gender: "non-binary", MinAge: 5, MaxAge: 10}, "gender"},
+		{"Diversity rate above 1", PersonOptions{DiversityRate: 1.5}, "diversity_rate"},
+		{"Unknown nationality", PersonOptions{Nationality: "XX"}, "nationality"},
+		{"Foreign minor", PersonOptions{Nationality: "VE", MaxAge: 10}, "nationality"},
+		{"Foreign born in a state", PersonOptions{Nationality: "foreign", BirthState: "SP"}, "birth_state"},
+		{"City not in the state", PersonOptions{State: "SP", City: "Manaus"}, "city"},
+		{"Unknown city", PersonOptions{City: "Cidade Inexistente"}, "city"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gen.NormalizePersonOptions(tt.opts)
+
+			var optionErr *PersonOptionError
+			assert.True(t, errors.As(err, &optionErr), "Expected a PersonOptionError")
+			if optionErr != nil {
+				assert.Equal(t, tt.parameter, optionErr.Parameter)
+			}
+		})
+	}
+}
+
+func TestGeneratePersonWithOptions_Constraints(t *testing.T) {
+	gen := newTestGenerator(t)
+	noCompany := false
+
+	for i := 0; i < 30; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{
+			MinAge:         30,
+			MaxAge:         35,
+			ProfessionArea: "Tecnologia",
+			Education:      "Mestrado",
+			MaritalStatus:  "divorced",
+			BloodType:      "O-",
+			BirthState:     "PE",
+			HasCompany:     &noCompany,
+		})
+
+		assert.GreaterOrEqual(t, person.Age, 30)
+		assert.LessOrEqual(t, person.Age, 35)
+		assert.Equal(t, "Tecnologia", person.Profession.Area)
+		assert.Equal(t, "Mestrado", person.Education)
+		assert.Equal(t, "divorced", person.MaritalStatus)
+		assert.Equal(t, "O-", person.BloodType)
+		assert.Equal(t, "PE", person.BirthState)
+		assert.Nil(t, person.Company)
+	}
+}
+
+func TestGeneratePersonWithOptions_InvertedAges(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 30; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{MinAge: 60, MaxAge: 20})
+
+		assert.GreaterOrEqual(t, person.Age, DefaultMinAge, "Invalid ages should fall back to the default bounds")
+		assert.LessOrEqual(t, person.Age, DefaultMaxAge, "Invalid ages should fall back to the default bounds")
+	}
+}
+
+func TestGeneratePersonWithOptions_KeepsValidConstraints(t *testing.T) {
+	gen := newTestGenerator(t)
+	noCompany := false
+
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, GenderFemale, gen.GeneratePerson(GenderFemale, "XX").Gender, "An unknown state should not drop the gender")
+
+		person := gen.GeneratePersonWithOptions(PersonOptions{
+			Gender:     GenderMale,
+			State:      "SP",
+			MinAge:     30,
+			MaxAge:     40,
+			Education:  "Alquimia",
+			BloodType:  "Z+",
+			HasCompany: &noCompany,
+		})
+		assert.Equal(t, GenderMale, person.Gender)
+		assert.Equal(t, "SP", person.Address.State)
+		assert.GreaterOrEqual(t, person.Age, 30)
+		assert.LessOrEqual(t, person.Age, 40)
+		assert.Nil(t, person.Company)
+	}
+}
+
+func TestDropPersonOption(t *testing.T) {
+	opts := PersonOptions{MinAge: 60, MaxAge: 20, State: "XX"}
+
+	assert.True(t, dropPersonOption(&opts, "min_age"))
+	assert.Zero(t, opts.MinAge)
+	assert.Zero(t, opts.MaxAge, "The age bounds should be dropped together")
+	assert.Equal(t, "XX", opts.State)
+
+	assert.False(t, dropPersonOption(&opts, "max_age"), "Nothing is left to drop")
+	assert.False(t, dropPersonOption(&opts, "unknown"))
+}
+
+func TestGenerateBirthdate_InvertedBounds(t *testing.T) {
+	for i := 0; i < 30; i++ {
+		age := calculateAge(generateBirthdate(40, 30))
+
+		assert.GreaterOrEqual(t, age, 30)
+		assert.LessOrEqual(t, age, 40)
+	}
+}
+
+func TestGeneratePerson_EducationMatchesAge(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	for i := 0; i < 50; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{MinAge: 18, MaxAge: 19})
+		assert.LessOrEqual(t, gen.dataStore.GetEducationMinAge(person.Education), person.Age,
+			"Education %s is not reachable at %d years old", person.Education, person.Age)
+	}
+}
+
+func TestNormalizePersonOptions_City(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	opts, err := gen.NormalizePersonOptions(PersonOptions{City: "manaus"})
+	assert.NoError(t, err)
+	assert.Equal(t, "AM", opts.State, "The state of the city should be used")
+	assert.Equal(t, "Manaus", opts.City)
+
+	person := gen.GeneratePersonWithOptions(PersonOptions{State: "SC", City: "blumenau"})
+	assert.Equal(t, "Blumenau", person.Address.City)
+	assert.Equal(t, "SC", person.Address.State)
+}
@@ -114,3 +114,187 @@ func calculateAgeFromBirthdate(birthdate time.Time) int {
 	return age
 }
 
+func TestGeneratePerson_Salary(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	person := gen.GeneratePerson("", "")
+
+	assert.Greater(t, person.Profession.SalaryValue, 0.0, "Salary value should be set")
+	assert.Equal(t, FormatBRL(person.Profession.SalaryValue), person.Profession.Salary, "Salary should be formatted as BRL")
+}
+
+func TestGeneratePerson_SalaryRange(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	for i := 0; i < 50; i++ {
+		person := gen.GeneratePersonWithOptions(PersonOptions{MinSalary: 5000, MaxSalary: 7000})
+		assert.GreaterOrEqual(t, person.Profession.SalaryValue, 5000.0, "Salary should respect the minimum")
+		assert.LessOrEqual(t, person.Profession.SalaryValue, 7000.0, "Salary should respect the maximum")
+	}
+}
+
+func TestGeneratePerson_RealisticDistribution(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	const samples = 2000
+	realistic, uniform := 0, 0
+	for i := 0; i < samples; i++ {
+		if gen.pickLastName(nameProfile{distribution: NameDistributionRealistic}) == "Silva" {
+			realistic++
+		}
+		if gen.pickLastName(nameProfile{distribution: NameDistributionUniform}) == "Silva" {
+			uniform++
+		}
+	}
+
+	// Silva is by far the most common surname, it must show up much more often than in a uniform draw
+	assert.Greater(t, realistic, 3*uniform, "Realistic distribution should favor common surnames")
+}
+
+func TestGetWeightedFirstName_BirthDecade(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+
+	modern := map[string]bool{"Enzo": true, "Davi": true, "Arthur": true, "Miguel": true, "Heitor": true, "Bernardo": true}
+	traditional := map[string]bool{"Sebastião": true, "Benedito": true, "Geraldo": true, "Raimundo": true, "Manoel": true}
+
+	const samples = 5000
+	modernIn1950, modernIn2015 := 0, 0
+	traditionalIn1950, traditionalIn2015 := 0, 0
+	for i := 0; i < samples; i++ {
+		old := ds.GetWeightedFirstName("male", "", 1950)
+		young := ds.GetWeightedFirstName("male", "", 2015)
+		if modern[old] {
+			modernIn1950++
+		}
+		if modern[young] {
+			modernIn2015++
+		}
+		if traditional[old] {
+			traditionalIn1950++
+		}
+		if traditional[young] {
+			traditionalIn2015++
+		}
+	}
+
+	assert.Greater(t, modernIn2015, 5*modernIn1950, "Modern names should be more common among recent births")
+	assert.Greater(t, traditionalIn1950, 3*traditionalIn2015, "Traditional names should be more common among older births")
+}
+
+func TestGetWeightedFirstName_Region(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+
+	const samples = 10000
+	northeast, south := 0, 0
+	for i := 0; i < samples; i++ {
+		if ds.GetWeightedFirstName("female", "Nordeste", 1960) == "Raimunda" {
+			northeast++
+		}
+		if ds.GetWeightedFirstName("female", "Sul", 1960) == "Raimunda" {
+			south++
+		}
+	}
+
+	assert.Greater(t, northeast, 5*south, "Raimunda should be much more common in the Northeast than in the South")
+}
+
+func TestBuildNameTables_RegionFactorsPerTable(t *testing.T) {
+	var data personData
+	data.FirstNames.Male = []string{"Francisco", "Pedro"}
+	data.FirstNames.Female = []string{"Conceição", "Ana"}
+	data.LastNames = []string{"Francisco", "Conceição", "Silva"}
+	data.NameFrequencies = nameFrequencies{
+		Male:      nameFrequencyData{Default: 1},
+		Female:    nameFrequencyData{Default: 1},
+		LastNames: nameFrequencyData{Default: 1},
+		Regions: map[string]regionNameFactors{
+			"Nordeste": {
+				FirstNames: map[string]float64{"Francisco": 1000},
+				LastNames:  map[string]float64{"Conceição": 1000},
+			},
+		},
+	}
+
+	ds := &DataStore{}
+	assert.NoError(t, ds.buildNameTables(&data))
+
+	const samples = 1000
+	firstFrancisco, lastFrancisco, firstConceicao, lastConceicao := 0, 0, 0, 0
+	for i := 0; i < samples; i++ {
+		if ds.GetWeightedFirstName("male", "Nordeste", 0) == "Francisco" {
+			firstFrancisco++
+		}
+		if ds.GetWeightedFirstName("female", "Nordeste", 0) == "Conceição" {
+			firstConceicao++
+		}
+		switch ds.GetWeightedLastName("Nordeste") {
+		case "Francisco":
+			lastFrancisco++
+		case "Conceição":
+			lastConceicao++
+		}
+	}
+
+	assert.Greater(t, firstFrancisco, samples*9/10, "The first name multiplier should favor the first name Francisco")
+	assert.Less(t, lastFrancisco, samples/10, "The first name multiplier should not apply to the surname Francisco")
+	assert.Greater(t, lastConceicao, samples*9/10, "The last name multiplier should favor the surname Conceição")
+	assert.Less(t, firstConceicao, samples*3/4, "The last name multiplier should not apply to the first name Conceição")
+}
+
+func TestGetWeightedName_UnknownRegionFallsBack(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+
+	assert.NotEmpty(t, ds.GetWeightedFirstName("male", "Atlantida", 1990))
+	assert.NotEmpty(t, ds.GetWeightedFirstName("female", "", 0))
+	assert.NotEmpty(t, ds.GetWeightedLastName("Atlantida"))
+}
+
+func TestNameDecade(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+
+	assert.Equal(t, 0, ds.nameDecade(0))
+	assert.Equal(t, 1980, ds.nameDecade(1987))
+	assert.Equal(t, ds.nameDecades[0], ds.nameDecade(1900))
+	assert.Equal(t, ds.nameDecades[len(ds.nameDecades)-1], ds.nameDecade(2090))
+}
+
+func TestNewNameProfile(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	profile := gen.newNameProfile(NameDistributionRealistic, "CE", "1975-03-12")
+	assert.Equal(t, "Nordeste", profile.region)
+	assert.Equal(t, 1975, profile.birthYear)
+	assert.Equal(t, 1975-parentAgeAtBirth, profile.parents().birthYear)
+
+	unknown := gen.newNameProfile(NameDistributionRealistic, "", "")
+	assert.Empty(t, unknown.region)
+	assert.Zero(t, unknown.birthYear)
+}
@@ -0,0 +1,114 @@
+package generators
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+)
+
+// attachPersona adds a credit card, a bank account and social handles derived from the person
+// so that every piece of data refers to the same identity
+// Minors only get social handles
+// Handles and the embossed card name use the social name when present, the bank account keeps the civil name
+func (g *Generator) attachPersona(person *models.Person) {
+	person.Social = generatePersonSocial(preferredName(person), person.Birthdate)
+	if isMinor(person.Age) {
+		return
+	}
+
+	number, cardBrand, cvv, expirationDate := generateCardData("")
+	person.CreditCard = &models.CreditCardResponse{
+		Number:         number,
+		Brand:          cardBrand,
+		CVV:            cvv,
+		ExpirationDate: expirationDate,
+		HolderName:     EmbossedName(preferredName(person)),
+	}
+
+	bank, agency, account, accountType := g.GenerateBankAccount("")
+	person.BankAccount = &models.PersonBankAccount{
+		Bank: models.Bank{
+			Code: bank.Code,
+			Name: bank.Name,
+		},
+		Agency:      agency,
+		Account:     account,
+		AccountType: accountType,
+		HolderName:  person.Name.FullName,
+		HolderCPF:   person.CPF.Masked,
+	}
+}
+
+// generatePersonSocial generates social network handles derived from the person's name
+func generatePersonSocial(name models.PersonName, birthdate string) *models.PersonSocial {
+	first, last := handleNameParts(name)
+
+	// Base handle in one of the usual formats
+	var handle string
+	switch rand.Intn(4) {
+	case 0:
+		handle = first + "." + last
+	case 1:
+		handle = first + last
+	case 2:
+		handle = first + "_" + last
+	default:
+		handle = first + last[:1]
+		if t, err := time.Parse("2006-01-02", birthdate); err == nil {
+			handle = fmt.Sprintf("%s%02d", handle, t.Year()%100)
+		}
+	}
+	handle = truncate(handle, 30)
+
+	// Twitter accepts only letters, numbers and underscores, up to 15 characters
+	twitter := truncate(strings.ReplaceAll(handle, ".", "_"), 15)
+
+	// LinkedIn and GitHub use hyphenated slugs
+	slug := strings.Trim(strings.NewReplacer(".", "-", "_", "-").Replace(handle), "-")
+	linkedInSlug := fmt.Sprintf("%s-%s-%06x", first, last, rand.Intn(0x1000000))
+
+	return &models.PersonSocial{
+		Username:  handle,
+		Instagram: "@" + handle,
+		Twitter:   "@" + twitter,
+		LinkedIn:  "https://www.linkedin.com/in/" + linkedInSlug,
+		GitHub:    "https://github.com/" + slug,
+	}
+}
+
+// handleNameParts returns the first name and the last surname in lowercase ASCII
+func handleNameParts(name models.PersonName) (first, last string) {
+	first = sanitizeHandle(strings.Fields(name.FirstName)[0])
+	lastNames := strings.Fields(name.LastName)
+	last = sanitizeHandle(lastNames[len(lastNames)-1])
+
+	if first == "" {
+		first = "user"
+	}
+	if last == "" {
+		last = "br"
+	}
+	return first, last
+}
+
+// sanitizeHandle keeps only lowercase ASCII letters and digits
+func sanitizeHandle(s string) string {
+	var result strings.Builder
+	for _, r := range strings.ToLower(removeAccents(s)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
+
+// truncate limits a string to n bytes, removing trailing separators
+func truncate(s string, n int) string {
+	if len(s) > n {
+		s = s[:n]
+	}
+	return strings.TrimRight(s, "._-")
+}
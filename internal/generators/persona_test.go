@@ -0,0 +1,92 @@
+package generators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/diogomcd/fake-mill-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for persona generation focusing on:
+// 1. Embossed holder names
+// 2. Email derived from the person's name
+// 3. Financial data and social handles tied to the same identity
+
+func TestEmbossedName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Two words", "Ana Souza", "ANA SOUZA"},
+		{"Middle names", "Maria Fernanda Souza Lima", "MARIA F S LIMA"},
+		{"Accents and connectors", "João da Conceição Araújo", "JOAO C ARAUJO"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, EmbossedName(models.PersonName{FullName: tt.input}))
+		})
+	}
+}
+
+func TestEmbossedName_MaxLength(t *testing.T) {
+	name := models.PersonName{FullName: "Maximiliano Bartolomeu Cristóvão Albuquerque Vasconcelos Figueiredo"}
+	assert.LessOrEqual(t, len(EmbossedName(name)), maxEmbossedNameLength)
+	assert.Equal(t, "MAXIMILIANO FIGUEIREDO", EmbossedName(name))
+}
+
+func TestGeneratePerson_EmailDerivedFromName(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	for i := 0; i < 20; i++ {
+		person := gen.GeneratePerson("female", "")
+		first := strings.ToLower(removeAccents(strings.Fields(person.Name.FirstName)[0]))
+		username := strings.Split(person.Email.Address, "@")[0]
+
+		if len(first) > 3 {
+			assert.True(t, strings.HasPrefix(username, first[:3]), "Email %s should derive from %s", username, person.Name.FullName)
+		}
+	}
+}
+
+func TestGeneratePerson_Persona(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	person := gen.GeneratePersonWithOptions(PersonOptions{Gender: "female", Persona: true})
+
+	assert.NotNil(t, person.CreditCard, "Persona should have a credit card")
+	assert.NotNil(t, person.BankAccount, "Persona should have a bank account")
+	assert.NotNil(t, person.Social, "Persona should have social handles")
+
+	assert.Equal(t, EmbossedName(person.Name), person.CreditCard.HolderName)
+	assert.Equal(t, person.Name.FullName, person.BankAccount.HolderName)
+	assert.Equal(t, person.CPF.Masked, person.BankAccount.HolderCPF)
+
+	first, _ := handleNameParts(person.Name)
+	assert.True(t, strings.HasPrefix(person.Social.Username, first), "Social handle should derive from the first name")
+	assert.Equal(t, "@"+person.Social.Username, person.Social.Instagram)
+}
+
+func TestGeneratePerson_NoPersonaByDefault(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	person := gen.GeneratePerson("", "")
+
+	assert.Nil(t, person.CreditCard)
+	assert.Nil(t, person.BankAccount)
+	assert.Nil(t, person.Social)
+}
@@ -8,6 +8,12 @@ import (
 
 // GeneratePhone generates a Brazilian phone number
 func (g *Generator) GeneratePhone(stateCode, requestedType string) (phone, ddd, state, phoneType string) {
+	return g.GeneratePhoneInCity(stateCode, "", requestedType)
+}
+
+// GeneratePhoneInCity generates a Brazilian phone number with the DDD of the city
+// A DDD of the state is used when the city is empty or unknown
+func (g *Generator) GeneratePhoneInCity(stateCode, city, requestedType string) (phone, ddd, state, phoneType string) {
 	ds := g.dataStore
 
 	var selectedState *StateData
@@ -20,7 +26,11 @@ func (g *Generator) GeneratePhone(stateCode, requestedType string) (phone, ddd,
 	}
 
 	state = selectedState.Code
-	ddd = ds.GetDDDForState(state)
+	if city != "" {
+		ddd = ds.GetDDDForCity(state, city)
+	} else {
+		ddd = ds.GetDDDForState(state)
+	}
 
 	if requestedType == "mobile" || requestedType == "landline" {
 		phoneType = requestedType
@@ -29,26 +39,30 @@ func (g *Generator) GeneratePhone(stateCode, requestedType string) (phone, ddd,
 		phoneType = types[rand.Intn(len(types))]
 	}
 
-	var phoneNumber string
+	phoneNumber := generatePhoneNumber(phoneType)
+
+	// Format with DDD: (XX) XXXXX-XXXX
+	phone = fmt.Sprintf("(%s) %s", ddd, phoneNumber)
+
+	return
+}
+
+// generatePhoneNumber generates the number of a phone of the given type, without the DDD
+func generatePhoneNumber(phoneType string) string {
 	if phoneType == "mobile" {
 		// Mobile: 9XXXX-XXXX
-		phoneNumber = fmt.Sprintf("9%d%d%d%d-%d%d%d%d",
-			rand.Intn(10), rand.Intn(10), rand.Intn(10), rand.Intn(10),
+		return fmt.Sprintf("9%d%d%d%d-%d%d%d%d",
 			rand.Intn(10), rand.Intn(10), rand.Intn(10), rand.Intn(10),
-		)
-	} else {
-		// Landline: 3XXXX-XXXX or 4XXXX-XXXX, etc
-		firstPart := 3 + rand.Intn(5) // 3 to 7
-		phoneNumber = fmt.Sprintf("%d%d%d%d-%d%d%d%d",
-			firstPart, rand.Intn(10), rand.Intn(10), rand.Intn(10),
 			rand.Intn(10), rand.Intn(10), rand.Intn(10), rand.Intn(10),
 		)
 	}
 
-	// Format with DDD: (XX) XXXXX-XXXX
-	phone = fmt.Sprintf("(%s) %s", ddd, phoneNumber)
-
-	return
+	// Landline: 3XXXX-XXXX or 4XXXX-XXXX, etc
+	firstPart := 3 + rand.Intn(5) // 3 to 7
+	return fmt.Sprintf("%d%d%d%d-%d%d%d%d",
+		firstPart, rand.Intn(10), rand.Intn(10), rand.Intn(10),
+		rand.Intn(10), rand.Intn(10), rand.Intn(10), rand.Intn(10),
+	)
 }
 
 // FormatPhone formats a phone number
@@ -0,0 +1,136 @@
+package generators
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+)
+
+// pickProfessionForSalary picks a profession of the area (any area when empty) whose salary range,
+// adjusted by education and age, overlaps the requested salary range
+// Returns the profession and the adjusted salary range already narrowed to the requested bounds
+func (g *Generator) pickProfessionForSalary(education string, age int, area string, minSalary, maxSalary float64) (ProfessionData, SalaryRange) {
+	ds := g.dataStore
+	factor := ds.GetEducationSalaryFactor(education) * ageSalaryFactor(age)
+
+	// Without constraints any profession fits
+	if area == "" && minSalary <= 0 && maxSalary <= 0 {
+		profession := ds.GetRandomProfession()
+		return profession, adjustSalaryRange(ds.GetSalaryRange(profession), factor)
+	}
+
+	professions := ds.GetProfessions()
+	inArea := make([]ProfessionData, 0, len(professions))
+	candidates := make([]ProfessionData, 0, len(professions))
+	for _, profession := range professions {
+		if area != "" && profession.Area != area {
+			continue
+		}
+		inArea = append(inArea, profession)
+
+		adjusted := adjustSalaryRange(ds.GetSalaryRange(profession), factor)
+		if _, ok := intersectSalaryRange(adjusted, minSalary, maxSalary); ok {
+			candidates = append(candidates, profession)
+		}
+	}
+
+	// Unknown area, ignore it
+	if len(inArea) == 0 {
+		return g.pickProfessionForSalary(education, age, "", minSalary, maxSalary)
+	}
+
+	// No profession can reach the requested range with this education and age,
+	// keep a random profession and clamp the salary to the requested bounds
+	if len(candidates) == 0 {
+		profession := inArea[rand.Intn(len(inArea))]
+		return profession, clampSalaryRange(minSalary, maxSalary)
+	}
+
+	profession := candidates[rand.Intn(len(candidates))]
+	adjusted := adjustSalaryRange(ds.GetSalaryRange(profession), factor)
+	narrowed, _ := intersectSalaryRange(adjusted, minSalary, maxSalary)
+	return profession, narrowed
+}
+
+// ageSalaryFactor returns a salary multiplier based on the years of experience
+// Goes from 0.75 at 18 years old to 1.3 after 30 years of career
+func ageSalaryFactor(age int) float64 {
+	experience := float64(age - 18)
+	if experience < 0 {
+		experience = 0
+	}
+	if experience > 30 {
+		experience = 30
+	}
+	return 0.75 + 0.55*experience/30
+}
+
+// adjustSalaryRange applies a multiplier to both bounds of a salary range
+func adjustSalaryRange(salaryRange SalaryRange, factor float64) SalaryRange {
+	return SalaryRange{
+		Min: salaryRange.Min * factor,
+		Max: salaryRange.Max * factor,
+	}
+}
+
+// intersectSalaryRange narrows a salary range to the requested bounds
+// A bound less than or equal to zero is ignored
+func intersectSalaryRange(salaryRange SalaryRange, minSalary, maxSalary float64) (SalaryRange, bool) {
+	if minSalary > 0 && minSalary > salaryRange.Min {
+		salaryRange.Min = minSalary
+	}
+	if maxSalary > 0 && maxSalary < salaryRange.Max {
+		salaryRange.Max = maxSalary
+	}
+	return salaryRange, salaryRange.Min <= salaryRange.Max
+}
+
+// clampSalaryRange builds a salary range from the requested bounds only
+func clampSalaryRange(minSalary, maxSalary float64) SalaryRange {
+	if maxSalary <= 0 {
+		return SalaryRange{Min: minSalary, Max: minSalary}
+	}
+	if minSalary <= 0 {
+		return SalaryRange{Min: maxSalary, Max: maxSalary}
+	}
+	return SalaryRange{Min: minSalary, Max: maxSalary}
+}
+
+// generateSalary generates a salary within the range, rounded to multiples of 50
+// The salary is never lower than the minimum wage unless the range requires it
+func generateSalary(salaryRange SalaryRange, minimumWage float64) float64 {
+	salary := salaryRange.Min + rand.Float64()*(salaryRange.Max-salaryRange.Min)
+
+	rounded := math.Round(salary/50) * 50
+	if rounded < salaryRange.Min || rounded > salaryRange.Max {
+		rounded = math.Round(salary*100) / 100
+	}
+
+	if rounded < minimumWage && salaryRange.Max >= minimumWage {
+		rounded = minimumWage
+	}
+
+	return rounded
+}
+
+// FormatBRL formats a value as Brazilian currency (ex: R$ 4.350,00)
+func FormatBRL(value float64) string {
+	cents := int64(math.Round(value * 100))
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	integerPart := fmt.Sprintf("%d", cents/100)
+	var grouped strings.Builder
+	for i, digit := range integerPart {
+		if i > 0 && (len(integerPart)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return fmt.Sprintf("%sR$ %s,%02d", sign, grouped.String(), cents%100)
+}
@@ -0,0 +1,70 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for salary generation focusing on:
+// 1. BRL formatting
+// 2. Range intersection
+// 3. Education and age correlation
+
+func TestFormatBRL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float64
+		expected string
+	}{
+		{"Thousands", 4350, "R$ 4.350,00"},
+		{"Cents", 1518.5, "R$ 1.518,50"},
+		{"Below thousand", 950, "R$ 950,00"},
+		{"Millions", 1234567.89, "R$ 1.234.567,89"},
+		{"Zero", 0, "R$ 0,00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatBRL(tt.input))
+		})
+	}
+}
+
+func TestIntersectSalaryRange(t *testing.T) {
+	narrowed, ok := intersectSalaryRange(SalaryRange{Min: 2000, Max: 8000}, 3000, 0)
+	assert.True(t, ok)
+	assert.Equal(t, SalaryRange{Min: 3000, Max: 8000}, narrowed)
+
+	_, ok = intersectSalaryRange(SalaryRange{Min: 2000, Max: 8000}, 9000, 12000)
+	assert.False(t, ok, "Disjoint ranges should not intersect")
+}
+
+func TestGenerateSalary_WithinRange(t *testing.T) {
+	salaryRange := SalaryRange{Min: 3000, Max: 3100}
+
+	for i := 0; i < 100; i++ {
+		salary := generateSalary(salaryRange, 1518)
+		assert.GreaterOrEqual(t, salary, salaryRange.Min)
+		assert.LessOrEqual(t, salary, salaryRange.Max)
+	}
+}
+
+func TestPickProfessionForSalary_EducationCorrelation(t *testing.T) {
+	ds, err := NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+	gen := NewGenerator(ds)
+
+	const count = 300
+	var lowTotal, highTotal float64
+	for i := 0; i < count; i++ {
+		_, low := gen.pickProfessionForSalary("Ensino Fundamental Incompleto", 40, "", 0, 0)
+		_, high := gen.pickProfessionForSalary("Doutorado", 40, "", 0, 0)
+		lowTotal += low.Min
+		highTotal += high.Min
+	}
+
+	assert.Greater(t, highTotal, lowTotal, "Higher education should lead to higher salaries")
+}
@@ -0,0 +1,92 @@
+package generators
+
+import (
+	"math"
+	"sort"
+)
+
+// earthRadiusKm is the mean radius of the Earth used by the haversine distance
+const earthRadiusKm = 6371.0
+
+// spatialCellSize is the side in degrees of the cells of the spatial index (about 11 km)
+const spatialCellSize = 0.1
+
+// NearbyAddress is a real address found near a point, with its distance in kilometers
+type NearbyAddress struct {
+	ZipcodeRecord
+	DistanceKm float64
+}
+
+// spatialIndex is a uniform grid over the coordinates of the real addresses
+type spatialIndex struct {
+	cells map[spatialCell][]spatialEntry
+}
+
+// spatialCell identifies a cell of the grid by its row (latitude) and column (longitude)
+type spatialCell struct {
+	row, col int
+}
+
+// spatialEntry is a real address stored in the grid with its parsed coordinates
+type spatialEntry struct {
+	record   ZipcodeRecord
+	lat, lng float64
+}
+
+// newSpatialIndex creates an empty spatial index
+func newSpatialIndex() *spatialIndex {
+	return &spatialIndex{cells: make(map[spatialCell][]spatialEntry)}
+}
+
+// add stores a real address with coordinates in the cell containing it
+func (idx *spatialIndex) add(record ZipcodeRecord) {
+	lat, lng := parseCoordinates(record.Latitude, record.Longitude)
+	cell := cellOf(lat, lng)
+	idx.cells[cell] = append(idx.cells[cell], spatialEntry{record: record, lat: lat, lng: lng})
+}
+
+// within returns the addresses at most radiusKm away from the point, sorted by distance
+// Only the cells overlapping the bounding box of the circle are visited
+func (idx *spatialIndex) within(lat, lng, radiusKm float64) []NearbyAddress {
+	kmPerDegree := math.Pi * earthRadiusKm / 180
+	deltaLat := radiusKm / kmPerDegree
+	// Meridians converge towards the poles, so a degree of longitude spans fewer kilometers
+	deltaLng := 180.0
+	if cos := math.Cos(lat * math.Pi / 180); cos > 0.01 {
+		deltaLng = math.Min(radiusKm/(kmPerDegree*cos), 180)
+	}
+
+	minCell, maxCell := cellOf(lat-deltaLat, lng-deltaLng), cellOf(lat+deltaLat, lng+deltaLng)
+	found := make([]NearbyAddress, 0)
+	for row := minCell.row; row <= maxCell.row; row++ {
+		for col := minCell.col; col <= maxCell.col; col++ {
+			for _, entry := range idx.cells[spatialCell{row, col}] {
+				if distance := haversineKm(lat, lng, entry.lat, entry.lng); distance <= radiusKm {
+					found = append(found, NearbyAddress{ZipcodeRecord: entry.record, DistanceKm: distance})
+				}
+			}
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].DistanceKm < found[j].DistanceKm })
+
+	return found
+}
+
+// cellOf returns the cell of the grid containing the point
+func cellOf(lat, lng float64) spatialCell {
+	return spatialCell{
+		row: int(math.Floor(lat / spatialCellSize)),
+		col: int(math.Floor(lng / spatialCellSize)),
+	}
+}
+
+// haversineKm returns the great-circle distance in kilometers between two points given in degrees
+func haversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	toRadians := math.Pi / 180
+	dLat := (lat2 - lat1) * toRadians
+	dLng := (lng2 - lng1) * toRadians
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*toRadians)*math.Cos(lat2*toRadians)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
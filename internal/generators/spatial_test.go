@@ -0,0 +1,87 @@
+package generators
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests for the spatial queries focusing on:
+// 1. Haversine distance between known points
+// 2. Grid search returning every address within the radius, sorted by distance
+// 3. Nearby addresses and reverse geocoding over the real database
+
+func TestHaversineKm(t *testing.T) {
+	assert.Equal(t, 0.0, haversineKm(-9.97, -67.82, -9.97, -67.82))
+	// One degree of latitude spans about 111.2 km
+	assert.InDelta(t, 111.19, haversineKm(0, 0, 1, 0), 0.01)
+	// São Paulo to Rio de Janeiro (Praça da Sé to Cinelândia)
+	assert.InDelta(t, 360, haversineKm(-23.5503, -46.6339, -22.9110, -43.1756), 2)
+}
+
+func TestSpatialIndex_Within(t *testing.T) {
+	idx := newSpatialIndex()
+	points := map[string][2]string{
+		"center": {"-9.97000", "-67.82000"},
+		"near":   {"-9.97500", "-67.82000"},
+		"cell":   {"-10.01000", "-67.82000"},
+		"far":    {"-10.50000", "-67.82000"},
+	}
+	for name, point := range points {
+		idx.add(ZipcodeRecord{RealAddress: RealAddress{Name: name, Latitude: point[0], Longitude: point[1]}})
+	}
+
+	found := idx.within(-9.97, -67.82, 5)
+	if assert.Len(t, found, 3, "Addresses in neighbouring cells should be found") {
+		assert.Equal(t, "center", found[0].Name)
+		assert.Equal(t, "near", found[1].Name)
+		assert.Equal(t, "cell", found[2].Name)
+		assert.InDelta(t, 0.556, found[1].DistanceKm, 0.001)
+	}
+
+	assert.Len(t, idx.within(-9.97, -67.82, 0.1), 1)
+	assert.Empty(t, idx.within(0, 0, 50))
+}
+
+func TestFindNearbyAddresses(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	response := gen.FindNearbyAddresses(-9.97, -67.82, 3, 5)
+
+	assert.Equal(t, 3.0, response.RadiusKm)
+	assert.Greater(t, response.Total, 5)
+	if assert.Len(t, response.Addresses, 5) {
+		for i, address := range response.Addresses {
+			if i > 0 {
+				assert.LessOrEqual(t, response.Addresses[i-1].DistanceKm, address.DistanceKm, "Addresses should be sorted by distance")
+			}
+			assert.LessOrEqual(t, address.DistanceKm, 3.0)
+			assert.Equal(t, "Rio Branco", address.City)
+			assert.Equal(t, "AC", address.State)
+			assert.Equal(t, "1200401", address.IBGECode)
+			assert.NotNil(t, address.Coordinates)
+		}
+	}
+
+	response = gen.FindNearbyAddresses(-9.97, -67.82, 500, 0)
+	assert.Equal(t, float64(DefaultNearbyRadiusKm), response.RadiusKm, "An out of range radius should fall back to the default")
+	assert.LessOrEqual(t, len(response.Addresses), DefaultNearbyQuantity)
+
+	response = gen.FindNearbyAddresses(-9.97, -67.82, math.NaN(), 0)
+	assert.Equal(t, float64(DefaultNearbyRadiusKm), response.RadiusKm, "A NaN radius should fall back to the default")
+}
+
+func TestReverseGeocode(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	address := gen.ReverseGeocode(-9.96598, -67.83782)
+	if assert.NotNil(t, address) {
+		assert.Equal(t, "Avenida Ceará", address.Street)
+		assert.Equal(t, "69918-111", address.Zipcode)
+		assert.Equal(t, 0.0, address.DistanceKm)
+	}
+
+	// Middle of the Atlantic Ocean
+	assert.Nil(t, gen.ReverseGeocode(-20, -20))
+}
@@ -1,6 +1,12 @@
 package handlers
 
 import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/diogomcd/fake-mill-api/internal/generators"
 	"github.com/diogomcd/fake-mill-api/internal/middleware"
 	"github.com/diogomcd/fake-mill-api/internal/models"
 	"github.com/gofiber/fiber/v2"
@@ -9,19 +15,22 @@ import (
 
 // AddressHandler handles requests to the /api/v1/address endpoint
 // @Summary Gera endereço brasileiro fictício
-// @Description Gera um ou mais endereços brasileiros fictícios, com opção de estado.
+// @Description Gera um ou mais endereços brasileiros fictícios, com opção de estado, cidade, região e capital. A cidade ignora maiúsculas e acentos (ex: sao paulo) e deve existir no estado informado. Com capital=true, somente as capitais presentes na base de endereços são sorteadas. O logradouro inclui o tipo (ex: Avenida Ceará, abreviado como Av. Ceará), e o endereço vem formatado em uma linha, como etiqueta dos Correios e como um usuário digitaria.
 // @Tags Endereço
 // @Accept json
 // @Produce json
 // @Param quantity query int false "Quantidade de endereços (1-200)" minimum(1) maximum(200) default(1)
 // @Param state query string false "UF do estado (ex: SP, RJ)"
+// @Param city query string false "Nome da cidade (ex: São Paulo, campinas)"
+// @Param region query string false "Região do país" Enums(Norte, Nordeste, Centro-Oeste, Sudeste, Sul)
+// @Param capital query bool false "Somente capitais (true) ou somente cidades do interior (false)"
 // @Success 200 {object} models.Address
 // @Success 200 {array} models.Address
+// @Failure 400 {object} map[string]string
 // @Router /address [get]
 func AddressHandler(c *fiber.Ctx) error {
 	gen := middleware.GetGenerator(c)
 	state := c.Query("state", "")
-	city := c.Query("city", "")
 
 	originalState := state
 	state = gen.GetDataStore().ValidateAndSanitizeState(state)
@@ -34,34 +43,210 @@ func AddressHandler(c *fiber.Ctx) error {
 			Msg("Invalid state code provided, using random state")
 	}
 
+	location, err := parseLocationOptions(c, gen, "AddressHandler", state)
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
 	log.Debug().
 		Str("handler", "AddressHandler").
-		Str("state", state).
-		Str("city", city).
+		Str("state", location.State).
+		Str("city", location.City).
+		Str("region", location.Region).
 		Msg("Address generation requested")
 
 	return generateMultiple(c, func() models.Address {
-		return *gen.GenerateAddress(state, city)
+		return *gen.GenerateAddress(gen.PickLocation(location))
 	})
 }
 
+// NearbyAddressesHandler handles requests to the /api/v1/address/near endpoint
+// @Summary Busca endereços próximos a um ponto
+// @Description Sorteia logradouros reais dentro de um raio em torno das coordenadas informadas, ordenados pela distância (haversine) em quilômetros. O total informa quantos logradouros da base estão dentro do raio.
+// @Tags Endereço
+// @Accept json
+// @Produce json
+// @Param lat query number true "Latitude do ponto (-90 a 90)" minimum(-90) maximum(90)
+// @Param lng query number true "Longitude do ponto (-180 a 180)" minimum(-180) maximum(180)
+// @Param radius_km query number false "Raio da busca em quilômetros (até 50)" maximum(50) default(5)
+// @Param quantity query int false "Quantidade máxima de endereços (1-200)" minimum(1) maximum(200) default(10)
+// @Success 200 {object} models.NearbyAddressesResponse
+// @Failure 400 {object} map[string]string
+// @Router /address/near [get]
+func NearbyAddressesHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+
+	lat, err := parseCoordinate(c, "lat", 90)
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+	lng, err := parseCoordinate(c, "lng", 180)
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	// Absent parameters parse as zero and fall back to the defaults
+	radius, err := parseOptionalFloat(c, "radius_km")
+	if err != nil || math.IsNaN(radius) || math.IsInf(radius, 0) || radius < 0 || radius > generators.MaxNearbyRadiusKm ||
+		(radius == 0 && c.Query("radius_km") != "") {
+		return badRequest(c, fmt.Sprintf("radius_km must be greater than 0 and at most %d", generators.MaxNearbyRadiusKm), "invalid_parameter")
+	}
+
+	quantity, err := parseOptionalInt(c, "quantity")
+	if err != nil || quantity > maxQuantity || (quantity < minQuantity && c.Query("quantity") != "") {
+		return badRequest(c, fmt.Sprintf("quantity must be between %d and %d", minQuantity, maxQuantity), "invalid_parameter")
+	}
+
+	response := gen.FindNearbyAddresses(lat, lng, radius, quantity)
+
+	log.Debug().
+		Str("handler", "NearbyAddressesHandler").
+		Float64("lat", lat).
+		Float64("lng", lng).
+		Float64("radius_km", response.RadiusKm).
+		Int("found", response.Total).
+		Msg("Nearby addresses requested")
+
+	if err := middleware.ValidateStruct(response); err != nil {
+		log.Error().
+			Err(err).
+			Str("handler", "NearbyAddressesHandler").
+			Str("error_type", "response_validation_failed").
+			Msg("Nearby addresses validation failed")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "internal server error",
+			"code":  "validation_failed",
+		})
+	}
+
+	return c.JSON(response)
+}
+
+// ReverseGeocodeHandler handles requests to the /api/v1/address/reverse endpoint
+// @Summary Geocodificação reversa
+// @Description Retorna o logradouro real mais próximo das coordenadas informadas, com a distância (haversine) em quilômetros. Responde 404 quando não há logradouro da base a até 50 km.
+// @Tags Endereço
+// @Accept json
+// @Produce json
+// @Param lat query number true "Latitude do ponto (-90 a 90)" minimum(-90) maximum(90)
+// @Param lng query number true "Longitude do ponto (-180 a 180)" minimum(-180) maximum(180)
+// @Success 200 {object} models.NearbyAddressResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /address/reverse [get]
+func ReverseGeocodeHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+
+	lat, err := parseCoordinate(c, "lat", 90)
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+	lng, err := parseCoordinate(c, "lng", 180)
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	address := gen.ReverseGeocode(lat, lng)
+
+	log.Debug().
+		Str("handler", "ReverseGeocodeHandler").
+		Float64("lat", lat).
+		Float64("lng", lng).
+		Bool("found", address != nil).
+		Msg("Reverse geocoding processed")
+
+	if address == nil {
+		return notFound(c, fmt.Sprintf("no street within %d km of the point", generators.MaxReverseDistanceKm), "address_not_found")
+	}
+
+	return c.JSON(address)
+}
+
+// AddressAutocompleteHandler handles requests to the /api/v1/address/autocomplete endpoint
+// @Summary Autocompleta logradouros
+// @Description Sugere logradouros reais cujo nome (com o tipo) começa com as palavras digitadas, sem diferenciar maiúsculas e acentos (ex: "r padre" encontra Rua Padre André). As sugestões trazem CEP e bairro, ordenadas por relevância, e podem ser restritas por estado e cidade.
+// @Tags Endereço
+// @Accept json
+// @Produce json
+// @Param q query string true "Início do nome do logradouro (mínimo de 2 letras ou dígitos)"
+// @Param state query string false "UF do estado (ex: AC, SP)"
+// @Param city query string false "Nome da cidade, sem diferenciar maiúsculas e acentos (ex: rio branco)"
+// @Param limit query int false "Quantidade máxima de sugestões (1-50)" minimum(1) maximum(50) default(10)
+// @Success 200 {object} models.AutocompleteResponse
+// @Failure 400 {object} map[string]string
+// @Router /address/autocomplete [get]
+func AddressAutocompleteHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	ds := gen.GetDataStore()
+	query := strings.TrimSpace(c.Query("q", ""))
+
+	if len([]rune(strings.Join(strings.FieldsFunc(query, isNotLetterOrDigit), ""))) < generators.MinAutocompleteQueryLength {
+		return badRequest(c, fmt.Sprintf("q must have at least %d letters or digits", generators.MinAutocompleteQueryLength), "invalid_parameter")
+	}
+
+	limit, err := parseOptionalInt(c, "limit")
+	if err != nil || limit > generators.MaxAutocompleteLimit || (limit == 0 && c.Query("limit") != "") {
+		return badRequest(c, fmt.Sprintf("limit must be between 1 and %d", generators.MaxAutocompleteLimit), "invalid_parameter")
+	}
+
+	// Unlike the generators, a search in an unknown state or city is an error rather than a random location
+	state := strings.TrimSpace(c.Query("state", ""))
+	if state != "" {
+		state = ds.ValidateAndSanitizeState(state)
+		if state == "" {
+			return badRequest(c, "state must be a valid state code (ex: SP, RJ)", "invalid_parameter")
+		}
+	}
+
+	city := strings.TrimSpace(c.Query("city", ""))
+	if city != "" {
+		cityState, name, ok := ds.FindCity(state, city)
+		if !ok {
+			return badRequest(c, fmt.Sprintf("city %q not found", city), "invalid_parameter")
+		}
+		state, city = cityState, name
+	}
+
+	response := gen.AutocompleteStreets(query, state, city, limit)
+
+	log.Debug().
+		Str("handler", "AddressAutocompleteHandler").
+		Str("query", query).
+		Str("state", state).
+		Str("city", city).
+		Int("suggestions", len(response.Suggestions)).
+		Msg("Address autocomplete requested")
+
+	return c.JSON(response)
+}
+
+// isNotLetterOrDigit reports whether the rune separates the words of a query
+func isNotLetterOrDigit(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
 // ZipcodeHandler handles requests to the /api/v1/zipcode endpoint
 // @Summary Gera CEP válido
-// @Description Gera um ou mais CEPs válidos, com opção de estado.
+// @Description Gera um ou mais CEPs válidos, com opção de estado, cidade, região e capital. A cidade ignora maiúsculas e acentos (ex: sao paulo) e deve existir no estado informado. Com capital=true, somente as capitais presentes na base de endereços são sorteadas.
 // @Tags Endereço
 // @Accept json
 // @Produce json
 // @Param quantity query int false "Quantidade de CEPs (1-200)" minimum(1) maximum(200) default(1)
 // @Param state query string false "UF do estado (ex: SP, RJ)"
+// @Param city query string false "Nome da cidade (ex: São Paulo, campinas)"
+// @Param region query string false "Região do país" Enums(Norte, Nordeste, Centro-Oeste, Sudeste, Sul)
+// @Param capital query bool false "Somente capitais (true) ou somente cidades do interior (false)"
 // @Success 200 {object} models.ZipcodeResponse
 // @Success 200 {array} models.ZipcodeResponse
+// @Failure 400 {object} map[string]string
 // @Router /zipcode [get]
 func ZipcodeHandler(c *fiber.Ctx) error {
 	gen := middleware.GetGenerator(c)
+	ds := gen.GetDataStore()
 	state := c.Query("state", "")
 
 	originalState := state
-	state = gen.GetDataStore().ValidateAndSanitizeState(state)
+	state = ds.ValidateAndSanitizeState(state)
 
 	if originalState != "" && state == "" {
 		log.Warn().
@@ -71,19 +256,117 @@ func ZipcodeHandler(c *fiber.Ctx) error {
 			Msg("Invalid state code provided, using random state")
 	}
 
+	location, err := parseLocationOptions(c, gen, "ZipcodeHandler", state)
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
 	log.Debug().
 		Str("handler", "ZipcodeHandler").
-		Str("state", state).
+		Str("state", location.State).
+		Str("city", location.City).
+		Str("region", location.Region).
 		Msg("Zipcode generation requested")
 
 	return generateMultiple(c, func() models.ZipcodeResponse {
-		formatted, unformatted, state, city := gen.GenerateZipcodeDetails(state)
-		return models.ZipcodeResponse{
+		formatted, unformatted, state, city := gen.GenerateZipcodeDetails(gen.PickLocation(location))
+		response := models.ZipcodeResponse{
 			Zipcode:     formatted,
 			Formatted:   formatted,
 			Unformatted: unformatted,
 			State:       state,
 			City:        city,
+			IBGECode:    ds.GetCityIBGECode(state, city),
+		}
+		if stateData := ds.GetStateByCode(state); stateData != nil {
+			response.Region = stateData.Region
 		}
+		return response
+	})
+}
+
+// ZipcodeLookupHandler handles requests to the /api/v1/zipcode/{cep} endpoint
+// @Summary Consulta CEP
+// @Description Consulta um CEP na base de endereços reais e retorna logradouro (com o tipo), bairro, cidade, código IBGE, UF, DDD e coordenadas.
+// @Tags Endereço
+// @Accept json
+// @Produce json
+// @Param cep path string true "CEP a consultar (com ou sem máscara)"
+// @Success 200 {object} models.ZipcodeLookupResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /zipcode/{cep} [get]
+func ZipcodeLookupHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	cep := c.Params("cep")
+
+	if !generators.ValidateCEP(cep) {
+		log.Warn().
+			Str("handler", "ZipcodeLookupHandler").
+			Str("cep", cep).
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid CEP received")
+		return badRequest(c, "cep must have 8 digits (ex: 69918-111 or 69918111)", "invalid_parameter")
+	}
+
+	address := gen.LookupZipcode(cep)
+
+	log.Debug().
+		Str("handler", "ZipcodeLookupHandler").
+		Str("cep", cep).
+		Bool("found", address != nil).
+		Msg("Zipcode lookup processed")
+
+	if address == nil {
+		return notFound(c, "cep not found", "zipcode_not_found")
+	}
+
+	return c.JSON(address)
+}
+
+// ViaCEPHandler handles requests to the /ws/{cep}/json endpoint, compatible with the ViaCEP web service
+// @Summary Consulta CEP no formato ViaCEP
+// @Description Consulta um CEP na base de endereços reais e responde no mesmo formato do ViaCEP, permitindo apontar clientes do ViaCEP para esta API sem alterações. CEPs desconhecidos retornam {"erro": true}, como no serviço original.
+// @Tags Endereço
+// @Produce json
+// @Param cep path string true "CEP a consultar, com 8 dígitos"
+// @Success 200 {object} models.ViaCEPResponse
+// @Failure 400 {object} map[string]string
+// @Router /ws/{cep}/json [get]
+func ViaCEPHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	cep := c.Params("cep")
+
+	if !generators.ValidateCEP(cep) {
+		log.Warn().
+			Str("handler", "ViaCEPHandler").
+			Str("cep", cep).
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid CEP received")
+		return badRequest(c, "cep must have 8 digits", "invalid_parameter")
+	}
+
+	address := gen.LookupZipcode(cep)
+
+	log.Debug().
+		Str("handler", "ViaCEPHandler").
+		Str("cep", cep).
+		Bool("found", address != nil).
+		Msg("ViaCEP lookup processed")
+
+	if address == nil {
+		return c.JSON(fiber.Map{"erro": true})
+	}
+
+	return c.JSON(models.ViaCEPResponse{
+		CEP:        address.Zipcode,
+		Logradouro: address.Street,
+		Bairro:     address.Neighborhood,
+		Localidade: address.City,
+		UF:         address.State,
+		Estado:     address.StateName,
+		Regiao:     address.Region,
+		IBGE:       address.IBGECode,
+		DDD:        address.DDD,
 	})
 }
@@ -26,7 +26,12 @@ func setupAddressApp() *fiber.App {
 	app.Use(middleware.InjectGenerator(gen))
 	v1 := app.Group("/api/v1")
 	v1.Get("/address", AddressHandler)
+	v1.Get("/address/near", NearbyAddressesHandler)
+	v1.Get("/address/reverse", ReverseGeocodeHandler)
+	v1.Get("/address/autocomplete", AddressAutocompleteHandler)
 	v1.Get("/zipcode", ZipcodeHandler)
+	v1.Get("/zipcode/:cep", ZipcodeLookupHandler)
+	app.Get("/ws/:cep/json", ViaCEPHandler)
 
 	return app
 }
@@ -82,3 +87,372 @@ func TestZipcodeHandler_Success(t *testing.T) {
 	assert.NotEmpty(t, zipcodeResp.State)
 	assert.NotEmpty(t, zipcodeResp.City)
 }
+
+func TestAddressHandler_City(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/address?state=PB&city=joao%20pessoa&quantity=5", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var addresses []models.Address
+	err = json.Unmarshal(body, &addresses)
+	assert.NoError(t, err)
+	assert.Len(t, addresses, 5)
+	for _, address := range addresses {
+		assert.Equal(t, "João Pessoa", address.City)
+		assert.Equal(t, "PB", address.State)
+	}
+}
+
+func TestAddressHandler_CityNotFound(t *testing.T) {
+	app := setupAddressApp()
+
+	for _, path := range []string{
+		"/api/v1/address?state=SP&city=Manaus",
+		"/api/v1/address?city=Cidade%20Inexistente",
+		"/api/v1/zipcode?state=RJ&city=Fortaleza",
+	} {
+		req := httptest.NewRequest("GET", path, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, "not found")
+	}
+}
+
+func TestZipcodeHandler_City(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/zipcode?city=Natal", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var zipcode models.ZipcodeResponse
+	err = json.Unmarshal(body, &zipcode)
+	assert.NoError(t, err)
+	assert.Equal(t, "Natal", zipcode.City)
+	assert.Equal(t, "RN", zipcode.State)
+}
+
+func TestZipcodeLookupHandler_Success(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/zipcode/13860-025", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var address models.ZipcodeLookupResponse
+	err = json.Unmarshal(body, &address)
+	assert.NoError(t, err)
+	assert.Equal(t, "13860-025", address.Zipcode)
+	assert.Equal(t, "Avenida Doutor Leonardo Guaranha", address.Street)
+	assert.Equal(t, "Aguaí", address.City)
+	assert.Equal(t, "3500303", address.IBGECode)
+	assert.Equal(t, "SP", address.State)
+	assert.NotNil(t, address.Coordinates)
+}
+
+func TestZipcodeLookupHandler_NotFound(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/zipcode/00000000", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 404, "not found")
+}
+
+func TestZipcodeLookupHandler_InvalidCEP(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/zipcode/1234", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "cep")
+}
+
+func TestViaCEPHandler(t *testing.T) {
+	app := setupAddressApp()
+
+	for _, path := range []string{"/ws/13860025/json/", "/ws/13860025/json"} {
+		req := httptest.NewRequest("GET", path, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var fields map[string]interface{}
+		err = json.Unmarshal(body, &fields)
+		assert.NoError(t, err)
+		for _, field := range []string{"cep", "logradouro", "complemento", "unidade", "bairro", "localidade", "uf", "estado", "regiao", "ibge", "gia", "ddd", "siafi"} {
+			assert.Contains(t, fields, field)
+		}
+		assert.Equal(t, "13860-025", fields["cep"])
+		assert.Equal(t, "Avenida Doutor Leonardo Guaranha", fields["logradouro"])
+		assert.Equal(t, "Aguaí", fields["localidade"])
+		assert.Equal(t, "SP", fields["uf"])
+		assert.Equal(t, "3500303", fields["ibge"])
+		assert.Equal(t, "19", fields["ddd"])
+	}
+}
+
+func TestViaCEPHandler_Unknown(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/ws/00000000/json/", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.JSONEq(t, `{"erro": true}`, string(body))
+
+	req = httptest.NewRequest("GET", "/ws/abc/json/", nil)
+	resp, err = app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+}
+
+func TestAddressHandler_RegionAndCapital(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/address?region=norte&capital=true&quantity=10", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var addresses []models.Address
+	err = json.Unmarshal(body, &addresses)
+	assert.NoError(t, err)
+	assert.Len(t, addresses, 10)
+	for _, address := range addresses {
+		assert.Contains(t, []string{"AC", "AM", "AP", "PA", "RO", "RR", "TO"}, address.State)
+		assert.Len(t, address.IBGECode, 7)
+		assert.Equal(t, address.IBGECode[:2], map[string]string{
+			"RO": "11", "AC": "12", "AM": "13", "RR": "14", "PA": "15", "AP": "16", "TO": "17",
+		}[address.State])
+	}
+}
+
+func TestAddressHandler_InvalidLocation(t *testing.T) {
+	app := setupAddressApp()
+
+	tests := []struct {
+		path      string
+		parameter string
+	}{
+		{"/api/v1/address?region=Leste", "region"},
+		{"/api/v1/address?state=BA&region=Sul", "region"},
+		{"/api/v1/address?state=SP&capital=true", "capital"},
+		{"/api/v1/address?capital=talvez", "capital"},
+		{"/api/v1/zipcode?state=AC&capital=false", "capital"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", tt.path, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, tt.parameter)
+	}
+}
+
+func TestZipcodeHandler_IBGECodeAndRegion(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/zipcode?state=AC", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var zipcode models.ZipcodeResponse
+	err = json.Unmarshal(body, &zipcode)
+	assert.NoError(t, err)
+	assert.Equal(t, "Rio Branco", zipcode.City)
+	assert.Equal(t, "1200401", zipcode.IBGECode)
+	assert.Equal(t, "Norte", zipcode.Region)
+}
+
+func TestNearbyAddressesHandler_Success(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/address/near?lat=-9.97&lng=-67.82&radius_km=2&quantity=4", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var nearby models.NearbyAddressesResponse
+	err = json.Unmarshal(body, &nearby)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, nearby.RadiusKm)
+	assert.Len(t, nearby.Addresses, 4)
+	for _, address := range nearby.Addresses {
+		assert.LessOrEqual(t, address.DistanceKm, 2.0)
+		assert.Equal(t, "AC", address.State)
+	}
+}
+
+func TestNearbyAddressesHandler_InvalidParameters(t *testing.T) {
+	app := setupAddressApp()
+
+	tests := []struct {
+		query     string
+		parameter string
+	}{
+		{"lng=-67.82", "lat"},
+		{"lat=abc&lng=-67.82", "lat"},
+		{"lat=-91&lng=-67.82", "lat"},
+		{"lat=-9.97&lng=181", "lng"},
+		{"lat=-9.97&lng=-67.82&radius_km=0", "radius_km"},
+		{"lat=-9.97&lng=-67.82&radius_km=51", "radius_km"},
+		{"lat=-9.97&lng=-67.82&radius_km=-1", "radius_km"},
+		{"lat=-9.97&lng=-67.82&radius_km=NaN", "radius_km"},
+		{"lat=-9.97&lng=-67.82&radius_km=-Inf", "radius_km"},
+		{"lat=-9.97&lng=-67.82&quantity=0", "quantity"},
+		{"lat=-9.97&lng=-67.82&quantity=201", "quantity"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/v1/address/near?"+tt.query, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, tt.parameter)
+	}
+}
+
+func TestReverseGeocodeHandler_Success(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/address/reverse?lat=-9.9660&lng=-67.8378", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var address models.NearbyAddressResponse
+	err = json.Unmarshal(body, &address)
+	assert.NoError(t, err)
+	assert.Equal(t, "Avenida Ceará", address.Street)
+	assert.Equal(t, "69918-111", address.Zipcode)
+	assert.Less(t, address.DistanceKm, 0.01)
+}
+
+func TestReverseGeocodeHandler_NotFound(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/address/reverse?lat=-20&lng=-20", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 404, "no street")
+
+	req = httptest.NewRequest("GET", "/api/v1/address/reverse?lat=-20", nil)
+	resp, err = app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "lng")
+}
+
+func TestAddressAutocompleteHandler_Success(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/address/autocomplete?q=rua%20padre&state=ac&city=RIO%20BRANCO", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var autocomplete models.AutocompleteResponse
+	err = json.Unmarshal(body, &autocomplete)
+	assert.NoError(t, err)
+	assert.Equal(t, "rua padre", autocomplete.Query)
+	if assert.Len(t, autocomplete.Suggestions, 1) {
+		assert.Equal(t, "Rua Padre André", autocomplete.Suggestions[0].Street)
+		assert.Equal(t, "69918-102", autocomplete.Suggestions[0].Zipcode)
+		assert.Equal(t, "7º BEC", autocomplete.Suggestions[0].Neighborhood)
+	}
+}
+
+func TestAddressAutocompleteHandler_NoSuggestions(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/address/autocomplete?q=zzzz", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), `"suggestions":[]`)
+}
+
+func TestAddressAutocompleteHandler_InvalidParameters(t *testing.T) {
+	app := setupAddressApp()
+
+	tests := []struct {
+		query     string
+		parameter string
+	}{
+		{"", "q"},
+		{"q=a.", "q"},
+		{"q=rua&limit=0", "limit"},
+		{"q=rua&limit=51", "limit"},
+		{"q=rua&state=XX", "state"},
+		{"q=rua&state=SP&city=Manaus", "city"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/v1/address/autocomplete?"+tt.query, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, tt.parameter)
+	}
+}
+
+func TestAddressHandler_Formats(t *testing.T) {
+	app := setupAddressApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/address?state=AC", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var address models.Address
+	err = json.Unmarshal(body, &address)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, address.StreetAbbreviated)
+	if assert.NotNil(t, address.Formatted) {
+		assert.Contains(t, address.Formatted.SingleLine, address.Street)
+		assert.Contains(t, address.Formatted.Label, "\nCEP "+address.Zipcode+" Rio Branco/AC")
+		assert.NotEmpty(t, address.Formatted.Typed)
+	}
+}
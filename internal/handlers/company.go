@@ -1,6 +1,11 @@
 package handlers
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/diogomcd/fake-mill-api/internal/generators"
 	"github.com/diogomcd/fake-mill-api/internal/middleware"
 	"github.com/diogomcd/fake-mill-api/internal/models"
 	"github.com/gofiber/fiber/v2"
@@ -9,22 +14,280 @@ import (
 
 // CompanyHandler handles requests to the /api/v1/company endpoint
 // @Summary Gera dados completos de empresa fictícia
-// @Description Gera dados completos de uma ou mais empresas fictícias, incluindo nome, CNPJ, endereço, etc.
+// @Description Gera dados completos de uma ou mais empresas fictícias, incluindo nome, CNPJ, endereço, natureza jurídica coerente com o sufixo do nome (LTDA, SLU, EIRELI, S.A.), porte, capital social, opção pelo Simples Nacional, situação cadastral, atividades CNAE e quadro de sócios e administradores (QSA). O telefone usa o DDD da cidade do endereço e o email de contato usa um domínio derivado do nome fantasia.
 // @Tags Empresa
 // @Accept json
 // @Produce json
 // @Param quantity query int false "Quantidade de empresas (1-200)" minimum(1) maximum(200) default(1)
+// @Param state query string false "Sigla do estado do endereço e do telefone (ex: SP, RJ)"
+// @Param region query string false "Região do endereço da empresa" Enums(Norte, Nordeste, Centro-Oeste, Sudeste, Sul)
+// @Param capital query bool false "Endereço da empresa somente em capitais (true) ou somente no interior (false)"
+// @Param cnae query string false "Código ou prefixo CNAE da atividade principal (ex: 62, 6201-5/01)"
+// @Param size query string false "Porte da empresa" Enums(ME, EPP, DEMAIS)
 // @Success 200 {object} models.CompanyResponse
 // @Success 200 {array} models.CompanyResponse
+// @Failure 400 {object} map[string]string
 // @Router /company [get]
 func CompanyHandler(c *fiber.Ctx) error {
 	gen := middleware.GetGenerator(c)
 
+	opts, err := parseCompanyOptions(c, gen, "CompanyHandler")
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
 	log.Debug().
 		Str("handler", "CompanyHandler").
+		Str("state", opts.State).
+		Str("cnae", opts.Activity).
+		Str("size", opts.Size).
 		Msg("Company generation requested")
 
 	return generateMultiple(c, func() models.CompanyResponse {
-		return *gen.GenerateCompany()
+		return *gen.GenerateCompanyWithOptions(opts)
+	})
+}
+
+// CompanyGroupHandler handles requests to the /api/v1/company-group endpoint
+// @Summary Gera grupo empresarial com matriz e filiais
+// @Description Gera um ou mais grupos empresariais: uma matriz (0001) e suas filiais (0002, 0003...) compartilhando a raiz do CNPJ, cada filial com dígitos verificadores corretos, inscrição estadual e endereço próprios, possivelmente em outros estados.
+// @Tags Empresa
+// @Accept json
+// @Produce json
+// @Param quantity query int false "Quantidade de grupos (1-200)" minimum(1) maximum(200) default(1)
+// @Param branches query int false "Quantidade de filiais (1-50), aleatória entre 1 e 5 se omitida" minimum(1) maximum(50)
+// @Param state query string false "Sigla do estado da matriz (ex: SP, RJ)"
+// @Param region query string false "Região do endereço da matriz" Enums(Norte, Nordeste, Centro-Oeste, Sudeste, Sul)
+// @Param capital query bool false "Endereço da matriz somente em capitais (true) ou somente no interior (false)"
+// @Param cnae query string false "Código ou prefixo CNAE da atividade principal (ex: 62, 6201-5/01)"
+// @Param size query string false "Porte da empresa" Enums(ME, EPP, DEMAIS)
+// @Success 200 {object} models.CompanyGroupResponse
+// @Success 200 {array} models.CompanyGroupResponse
+// @Failure 400 {object} map[string]string
+// @Router /company-group [get]
+func CompanyGroupHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+
+	opts, err := parseCompanyOptions(c, gen, "CompanyGroupHandler")
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	branches, err := parseOptionalInt(c, "branches")
+	if err == nil && c.Query("branches") != "" && (branches < generators.MinCompanyBranches || branches > generators.MaxCompanyBranches) {
+		err = fmt.Errorf("branches must be between %d and %d", generators.MinCompanyBranches, generators.MaxCompanyBranches)
+	}
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "CompanyGroupHandler").
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid branches parameter received")
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	log.Debug().
+		Str("handler", "CompanyGroupHandler").
+		Int("branches", branches).
+		Str("state", opts.State).
+		Str("cnae", opts.Activity).
+		Str("size", opts.Size).
+		Msg("Company group generation requested")
+
+	return generateMultiple(c, func() models.CompanyGroupResponse {
+		return *gen.GenerateCompanyGroup(opts, branches)
 	})
 }
+
+// generatedCompany is the path value of the employees endpoint that generates a new company
+const generatedCompany = "generated"
+
+// CompanyEmployeesHandler handles requests to the /api/v1/company/{cnpj}/employees endpoint
+// @Summary Gera quadro de funcionários de uma empresa
+// @Description Gera uma empresa e seus funcionários: pessoas cujo vínculo empregatício referencia o CNPJ e a razão social da empresa, com email corporativo no domínio da empresa, cargos das áreas ligadas à atividade CNAE principal, data de admissão posterior à fundação e salário. Use "generated" no lugar do CNPJ para gerar uma empresa nova.
+// @Tags Empresa
+// @Accept json
+// @Produce json
+// @Param cnpj path string true "CNPJ da empresa (com ou sem formatação) ou generated"
+// @Param count query int false "Quantidade de funcionários (1-200)" minimum(1) maximum(200) default(10)
+// @Param state query string false "Sigla do estado da empresa e dos funcionários (ex: SP, RJ)"
+// @Param region query string false "Região do endereço da empresa" Enums(Norte, Nordeste, Centro-Oeste, Sudeste, Sul)
+// @Param capital query bool false "Endereço da empresa somente em capitais (true) ou somente no interior (false)"
+// @Param cnae query string false "Código ou prefixo CNAE da atividade principal (ex: 62, 6201-5/01)"
+// @Param size query string false "Porte da empresa" Enums(ME, EPP, DEMAIS)
+// @Success 200 {object} models.CompanyEmployeesResponse
+// @Failure 400 {object} map[string]string
+// @Router /company/{cnpj}/employees [get]
+func CompanyEmployeesHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+
+	cnpj, err := url.PathUnescape(c.Params("cnpj"))
+	if err != nil {
+		cnpj = c.Params("cnpj")
+	}
+	if strings.EqualFold(cnpj, generatedCompany) {
+		cnpj = ""
+	} else if !generators.ValidateCNPJ(cnpj) {
+		log.Warn().
+			Str("handler", "CompanyEmployeesHandler").
+			Str("cnpj", cnpj).
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid CNPJ received")
+		return badRequest(c, "cnpj must be a valid CNPJ or generated", "invalid_parameter")
+	}
+
+	opts, err := parseCompanyOptions(c, gen, "CompanyEmployeesHandler")
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	count, err := parseOptionalInt(c, "count")
+	if err == nil && c.Query("count") != "" && (count < generators.MinCompanyEmployees || count > generators.MaxCompanyEmployees) {
+		err = fmt.Errorf("count must be between %d and %d", generators.MinCompanyEmployees, generators.MaxCompanyEmployees)
+	}
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "CompanyEmployeesHandler").
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid count parameter received")
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	log.Debug().
+		Str("handler", "CompanyEmployeesHandler").
+		Str("cnpj", cnpj).
+		Int("count", count).
+		Str("state", opts.State).
+		Str("cnae", opts.Activity).
+		Str("size", opts.Size).
+		Msg("Company employees generation requested")
+
+	roster := gen.GenerateCompanyEmployees(opts, cnpj, count)
+	if err := middleware.ValidateStruct(roster); err != nil {
+		log.Error().
+			Err(err).
+			Str("handler", "CompanyEmployeesHandler").
+			Str("error_type", "response_validation_failed").
+			Msg("Company employees validation failed")
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "internal server error",
+			"code":  "validation_failed",
+		})
+	}
+
+	return c.JSON(roster)
+}
+
+// MEIHandler handles requests to the /api/v1/mei endpoint
+// @Summary Gera microempreendedor individual (MEI)
+// @Description Gera um ou mais MEIs: uma pessoa titular e o CNPJ registrado em seu nome, com razão social no formato "NOME COMPLETO + CPF", atividade CNAE permitida para MEI, abertura após o titular completar 18 anos, faturamento anual abaixo do teto do MEI, o mesmo endereço, email e telefone do titular e profissão do titular ligada à atividade, nunca regulamentada por conselho.
+// @Tags Empresa
+// @Accept json
+// @Produce json
+// @Param quantity query int false "Quantidade de MEIs (1-200)" minimum(1) maximum(200) default(1)
+// @Param state query string false "Sigla do estado do titular (ex: SP, RJ)"
+// @Param cnae query string false "Código ou prefixo CNAE de atividade permitida para MEI (ex: 96, 9602-5/01)"
+// @Success 200 {object} models.MEIResponse
+// @Success 200 {array} models.MEIResponse
+// @Failure 400 {object} map[string]string
+// @Router /mei [get]
+func MEIHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+
+	state := c.Query("state")
+	cnae := strings.TrimSpace(c.Query("cnae"))
+
+	if cnae != "" && len(gen.GetDataStore().FindMEIActivities(cnae)) == 0 {
+		log.Warn().
+			Str("handler", "MEIHandler").
+			Str("cnae", cnae).
+			Str("error_type", "invalid_parameter").
+			Msg("CNAE not allowed for MEI received")
+		return badRequest(c, "cnae must be the code or prefix of an activity allowed for MEI (ex: 96, 9602-5/01)", "invalid_parameter")
+	}
+
+	originalState := state
+	state = gen.GetDataStore().ValidateAndSanitizeState(state)
+
+	if originalState != "" && state == "" {
+		log.Warn().
+			Str("handler", "MEIHandler").
+			Str("requested_state", originalState).
+			Str("error_type", "invalid_state_code").
+			Msg("Invalid state code provided, using random state")
+	}
+
+	log.Debug().
+		Str("handler", "MEIHandler").
+		Str("state", state).
+		Str("cnae", cnae).
+		Msg("MEI generation requested")
+
+	return generateMultiple(c, func() models.MEIResponse {
+		return *gen.GenerateMEI(state, cnae)
+	})
+}
+
+// parseCompanyOptions parses the state, cnae and size query parameters of the company endpoints
+// An invalid state falls back to a random one, while unknown CNAE codes and sizes are rejected
+func parseCompanyOptions(c *fiber.Ctx, gen generators.IGenerator, handler string) (generators.CompanyOptions, error) {
+	state := c.Query("state")
+	cnae := strings.TrimSpace(c.Query("cnae"))
+	size := strings.ToUpper(strings.TrimSpace(c.Query("size")))
+
+	if cnae != "" && len(gen.GetDataStore().FindActivities(cnae)) == 0 {
+		log.Warn().
+			Str("handler", handler).
+			Str("cnae", cnae).
+			Str("error_type", "invalid_parameter").
+			Msg("Unknown CNAE received")
+		return generators.CompanyOptions{}, fmt.Errorf("cnae must be the code or prefix of a known CNAE activity (ex: 62, 6201-5/01)")
+	}
+
+	if size != "" && !generators.IsValidCompanySize(size) {
+		log.Warn().
+			Str("handler", handler).
+			Str("size", size).
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid company size received")
+		return generators.CompanyOptions{}, fmt.Errorf("size must be one of: ME, EPP, DEMAIS")
+	}
+
+	originalState := state
+	state = gen.GetDataStore().ValidateAndSanitizeState(state)
+
+	if originalState != "" && state == "" {
+		log.Warn().
+			Str("handler", handler).
+			Str("requested_state", originalState).
+			Str("error_type", "invalid_state_code").
+			Msg("Invalid state code provided, using random state")
+	}
+
+	capital, err := parseOptionalBool(c, "capital")
+	if err != nil {
+		return generators.CompanyOptions{}, err
+	}
+	location, err := gen.NormalizeLocationOptions(generators.LocationOptions{
+		State:   state,
+		Region:  strings.TrimSpace(c.Query("region")),
+		Capital: capital,
+	})
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", handler).
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid location received")
+		return generators.CompanyOptions{}, err
+	}
+
+	return generators.CompanyOptions{
+		State:    location.State,
+		Region:   location.Region,
+		Capital:  location.Capital,
+		Activity: cnae,
+		Size:     size,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/diogomcd/fake-mill-api/internal/generators"
@@ -26,6 +27,9 @@ func setupCompanyApp() *fiber.App {
 	app.Use(middleware.InjectGenerator(gen))
 	v1 := app.Group("/api/v1")
 	v1.Get("/company", CompanyHandler)
+	v1.Get("/company-group", CompanyGroupHandler)
+	v1.Get("/company/:cnpj/employees", CompanyEmployeesHandler)
+	v1.Get("/mei", MEIHandler)
 
 	return app
 }
@@ -51,3 +55,194 @@ func TestCompanyHandler_Success(t *testing.T) {
 	assert.NotEmpty(t, company.Phone)
 	assert.NotEmpty(t, company.Address.Street)
 }
+
+func TestCompanyHandler_Filters(t *testing.T) {
+	app := setupCompanyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/company?state=rs&cnae=62&size=epp", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var company models.CompanyResponse
+	err = json.Unmarshal(body, &company)
+	assert.NoError(t, err)
+	assert.Equal(t, "RS", company.Address.State)
+	assert.Equal(t, "62", company.MainActivity.Code[:2])
+	assert.Equal(t, "EPP", company.Size)
+	assert.Contains(t, company.Email, "@"+company.Domain)
+}
+
+func TestCompanyHandler_RegionAndCapital(t *testing.T) {
+	app := setupCompanyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/company?region=sudeste&capital=true", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var company models.CompanyResponse
+	err = json.Unmarshal(body, &company)
+	assert.NoError(t, err)
+	// Belo Horizonte is the only capital of the region covered by the address database
+	assert.Equal(t, "Belo Horizonte", company.Address.City)
+	assert.Equal(t, "MG", company.Address.State)
+	assert.Equal(t, "3106200", company.Address.IBGECode)
+}
+
+func TestCompanyHandler_InvalidFilters(t *testing.T) {
+	app := setupCompanyApp()
+
+	tests := []struct {
+		query     string
+		parameter string
+	}{
+		{"cnae=0000", "cnae"},
+		{"size=GRANDE", "size"},
+		{"region=Leste", "region"},
+		{"region=Sul&capital=true", "capital"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/v1/company?"+tt.query, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, tt.parameter)
+	}
+}
+
+func TestCompanyGroupHandler_Success(t *testing.T) {
+	app := setupCompanyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/company-group?branches=3&state=PR", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var group models.CompanyGroupResponse
+	err = json.Unmarshal(body, &group)
+	assert.NoError(t, err)
+	assert.Equal(t, "PR", group.Headquarters.Address.State)
+	assert.Len(t, group.Branches, 3)
+	for i, branch := range group.Branches {
+		assert.Equal(t, fmt.Sprintf("%04d", i+2), branch.Branch)
+		assert.True(t, generators.ValidateCNPJ(branch.CNPJ), branch.CNPJ)
+	}
+}
+
+func TestCompanyGroupHandler_InvalidBranches(t *testing.T) {
+	app := setupCompanyApp()
+
+	for _, branches := range []string{"0", "51", "abc"} {
+		req := httptest.NewRequest("GET", "/api/v1/company-group?branches="+branches, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, "branches")
+	}
+}
+
+func TestMEIHandler_Success(t *testing.T) {
+	app := setupCompanyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/mei?state=MG&cnae=9602", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var mei models.MEIResponse
+	err = json.Unmarshal(body, &mei)
+	assert.NoError(t, err)
+	assert.Equal(t, "MG", mei.Address.State)
+	assert.True(t, strings.HasPrefix(mei.MainActivity.Code, "9602"))
+	assert.True(t, strings.HasSuffix(mei.Name, mei.Owner.CPF.Unmasked))
+	assert.True(t, generators.ValidateCNPJ(mei.CNPJ))
+}
+
+func TestMEIHandler_CNAENotAllowed(t *testing.T) {
+	app := setupCompanyApp()
+
+	for _, cnae := range []string{"6201", "0000"} {
+		req := httptest.NewRequest("GET", "/api/v1/mei?cnae="+cnae, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, "cnae")
+	}
+}
+
+func TestCompanyEmployeesHandler_Success(t *testing.T) {
+	app := setupCompanyApp()
+
+	for _, cnpj := range []string{"11222333000181", "11.222.333%2F0001-81"} {
+		req := httptest.NewRequest("GET", "/api/v1/company/"+cnpj+"/employees?count=15&state=RS", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		testutils.AssertJSONHeaders(t, resp)
+
+		body, _ := io.ReadAll(resp.Body)
+		var roster models.CompanyEmployeesResponse
+		err = json.Unmarshal(body, &roster)
+		assert.NoError(t, err)
+		assert.Equal(t, "11.222.333/0001-81", roster.Company.CNPJ)
+		assert.Equal(t, "RS", roster.Company.Address.State)
+		assert.Len(t, roster.Employees, 15)
+		for _, employee := range roster.Employees {
+			assert.Equal(t, roster.Company.CNPJ, employee.Person.Company.CNPJ)
+		}
+	}
+}
+
+func TestCompanyEmployeesHandler_Generated(t *testing.T) {
+	app := setupCompanyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/company/generated/employees", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var roster models.CompanyEmployeesResponse
+	err = json.Unmarshal(body, &roster)
+	assert.NoError(t, err)
+	assert.True(t, generators.ValidateCNPJ(roster.Company.CNPJ))
+	assert.Len(t, roster.Employees, generators.DefaultCompanyEmployees)
+}
+
+func TestCompanyEmployeesHandler_InvalidParameters(t *testing.T) {
+	app := setupCompanyApp()
+
+	tests := []struct {
+		path     string
+		contains string
+	}{
+		{"/api/v1/company/11222333000180/employees", "cnpj"},
+		{"/api/v1/company/abc/employees", "cnpj"},
+		{"/api/v1/company/generated/employees?count=0", "count"},
+		{"/api/v1/company/generated/employees?count=201", "count"},
+		{"/api/v1/company/generated/employees?count=abc", "count"},
+		{"/api/v1/company/generated/employees?size=XL", "size"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", tt.path, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, tt.contains)
+	}
+}
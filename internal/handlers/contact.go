@@ -40,15 +40,19 @@ func EmailHandler(c *fiber.Ctx) error {
 
 // PhoneHandler handles requests to the /api/v1/phone endpoint
 // @Summary Gera telefone brasileiro
-// @Description Gera um ou mais números de telefone brasileiros, com DDD e tipo.
+// @Description Gera um ou mais números de telefone brasileiros, com DDD e tipo. O DDD pode ser restrito por estado, cidade, região ou capital.
 // @Tags Contato
 // @Accept json
 // @Produce json
 // @Param quantity query int false "Quantidade de telefones (1-200)" minimum(1) maximum(200) default(1)
 // @Param state query string false "UF do estado (ex: SP, RJ)"
+// @Param city query string false "Cidade do DDD, sem diferenciar maiúsculas e acentos (ex: campinas)"
+// @Param region query string false "Região do DDD" Enums(Norte, Nordeste, Centro-Oeste, Sudeste, Sul)
+// @Param capital query bool false "DDD somente de capitais (true) ou somente do interior (false)"
 // @Param type query string false "Tipo de telefone" Enums(mobile, landline, random) default(random)
 // @Success 200 {object} models.PhoneResponse
 // @Success 200 {array} models.PhoneResponse
+// @Failure 400 {object} map[string]string
 // @Router /phone [get]
 func PhoneHandler(c *fiber.Ctx) error {
 	gen := middleware.GetGenerator(c)
@@ -66,14 +70,22 @@ func PhoneHandler(c *fiber.Ctx) error {
 			Msg("Invalid state code provided, using random state")
 	}
 
+	location, err := parseLocationOptions(c, gen, "PhoneHandler", state)
+	if err != nil {
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
 	log.Debug().
 		Str("handler", "PhoneHandler").
-		Str("state", state).
+		Str("state", location.State).
+		Str("city", location.City).
+		Str("region", location.Region).
 		Str("type", phoneType).
 		Msg("Phone generation requested")
 
 	return generateMultiple(c, func() models.PhoneResponse {
-		phone, ddd, phoneState, actualPhoneType := gen.GeneratePhone(state, phoneType)
+		phoneState, city := gen.PickLocation(location)
+		phone, ddd, phoneState, actualPhoneType := gen.GeneratePhoneInCity(phoneState, city, phoneType)
 		return models.PhoneResponse{
 			Phone:       phone,
 			Formatted:   phone,
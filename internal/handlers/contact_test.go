@@ -115,3 +115,32 @@ func TestPhoneHandler_InvalidState(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEqual(t, "XX", phoneResp.State)
 }
+
+func TestPhoneHandler_Capital(t *testing.T) {
+	app := setupContactApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/phone?state=AC&capital=true&quantity=5", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var phones []models.PhoneResponse
+	err = json.Unmarshal(body, &phones)
+	assert.NoError(t, err)
+	for _, phone := range phones {
+		assert.Equal(t, "68", phone.DDD)
+		assert.Equal(t, "AC", phone.State)
+	}
+}
+
+func TestPhoneHandler_InvalidRegion(t *testing.T) {
+	app := setupContactApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/phone?region=Oeste", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "region")
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/diogomcd/fake-mill-api/internal/generators"
 	"github.com/diogomcd/fake-mill-api/internal/middleware"
@@ -115,6 +116,169 @@ func RGHandler(c *fiber.Ctx) error {
 	})
 }
 
+// CertificateHandler handles requests to the /api/v1/certificate endpoint
+// @Summary Gera certidão de nascimento ou casamento
+// @Description Gera uma ou mais certidões de nascimento ou casamento com matrícula nacional de 32 dígitos (CNS do cartório, acervo, serviço, ano, tipo de livro, livro, folha, termo e dígitos verificadores). O ano da matrícula segue a data de nascimento ou casamento, e o CNS do cartório é compatível com o estado. Certidões de casamento trazem os dois cônjuges.
+// @Tags Documentos
+// @Accept json
+// @Produce json
+// @Param quantity query int false "Quantidade de certidões (1-200)" minimum(1) maximum(200) default(1)
+// @Param type query string false "Tipo da certidão" Enums(birth, marriage) default(birth)
+// @Param state query string false "UF do cartório (ex: SP, RJ)"
+// @Param date query string false "Data de nascimento (birth) ou de casamento (marriage) no formato YYYY-MM-DD, aleatória se omitida"
+// @Param distribution query string false "Distribuição dos nomes: realistic segue a frequência na população, uniform sorteia com a mesma chance" Enums(realistic, uniform) default(realistic)
+// @Success 200 {object} models.CertificateResponse
+// @Success 200 {array} models.CertificateResponse
+// @Failure 400 {object} map[string]string
+// @Router /certificate [get]
+func CertificateHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	certificateType := c.Query("type", generators.CertificateTypeBirth)
+	state := c.Query("state", "")
+
+	if certificateType != generators.CertificateTypeBirth && certificateType != generators.CertificateTypeMarriage {
+		log.Warn().
+			Str("handler", "CertificateHandler").
+			Str("type", certificateType).
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid certificate type received")
+		return badRequest(c, "type must be birth or marriage", "invalid_parameter")
+	}
+
+	date, err := parseOptionalDate(c, "date")
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "CertificateHandler").
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid certificate date received")
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	distribution, err := parseNameDistribution(c)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "CertificateHandler").
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid distribution parameter received")
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	originalState := state
+	state = gen.GetDataStore().ValidateAndSanitizeState(state)
+
+	if originalState != "" && state == "" {
+		log.Warn().
+			Str("handler", "CertificateHandler").
+			Str("requested_state", originalState).
+			Str("error_type", "invalid_state_code").
+			Msg("Invalid state code provided, using random state")
+	}
+
+	log.Debug().
+		Str("handler", "CertificateHandler").
+		Str("type", certificateType).
+		Str("state", state).
+		Str("date", date).
+		Msg("Certificate generation requested")
+
+	opts := generators.CertificateOptions{
+		Type:         certificateType,
+		State:        state,
+		Date:         date,
+		Distribution: distribution,
+	}
+
+	return generateMultiple(c, func() models.CertificateResponse {
+		return *gen.GenerateCertificate(opts)
+	})
+}
+
+// PassportHandler handles requests to the /api/v1/passport endpoint
+// @Summary Gera passaporte brasileiro
+// @Description Gera um ou mais passaportes brasileiros (duas letras e seis dígitos) emitidos pelo DPF, válidos na data atual. A validade é de 5 anos quando emitido para menores de idade e de 10 anos para adultos.
+// @Tags Documentos
+// @Accept json
+// @Produce json
+// @Param quantity query int false "Quantidade de passaportes (1-200)" minimum(1) maximum(200) default(1)
+// @Param birthdate query string false "Data de nascimento do titular no formato YYYY-MM-DD, aleatória se omitida"
+// @Success 200 {object} models.PassportResponse
+// @Success 200 {array} models.PassportResponse
+// @Failure 400 {object} map[string]string
+// @Router /passport [get]
+func PassportHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+
+	birthdate, err := parseOptionalDate(c, "birthdate")
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "PassportHandler").
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid birthdate parameter received")
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	log.Debug().
+		Str("handler", "PassportHandler").
+		Str("birthdate", birthdate).
+		Msg("Passport generation requested")
+
+	return generateMultiple(c, func() models.PassportResponse {
+		return *gen.GeneratePassport(birthdate)
+	})
+}
+
+// CouncilRegistrationHandler handles requests to the /api/v1/council-registration endpoint
+// @Summary Gera registro em conselho profissional
+// @Description Gera um ou mais registros profissionais (CRM, OAB, CREA, CRC ou COREN) no formato usual de cada conselho, junto com uma profissão regulamentada pelo conselho.
+// @Tags Documentos
+// @Accept json
+// @Produce json
+// @Param quantity query int false "Quantidade de registros (1-200)" minimum(1) maximum(200) default(1)
+// @Param council query string false "Conselho profissional, aleatório se omitido" Enums(CRM, OAB, CREA, CRC, COREN)
+// @Param state query string false "Sigla do estado do registro (ex: SP, RJ)"
+// @Success 200 {object} models.CouncilRegistrationResponse
+// @Success 200 {array} models.CouncilRegistrationResponse
+// @Failure 400 {object} map[string]string
+// @Router /council-registration [get]
+func CouncilRegistrationHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	council := strings.ToUpper(strings.TrimSpace(c.Query("council")))
+	state := c.Query("state")
+
+	if council != "" && !generators.IsValidCouncil(council) {
+		log.Warn().
+			Str("handler", "CouncilRegistrationHandler").
+			Str("council", council).
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid council received")
+		return badRequest(c, "council must be one of: "+strings.Join(generators.GetCouncilCodes(), ", "), "invalid_parameter")
+	}
+
+	originalState := state
+	state = gen.GetDataStore().ValidateAndSanitizeState(state)
+
+	if originalState != "" && state == "" {
+		log.Warn().
+			Str("handler", "CouncilRegistrationHandler").
+			Str("requested_state", originalState).
+			Str("error_type", "invalid_state_code").
+			Msg("Invalid state code provided, using random state")
+	}
+
+	log.Debug().
+		Str("handler", "CouncilRegistrationHandler").
+		Str("council", council).
+		Str("state", state).
+		Msg("Council registration generation requested")
+
+	return generateMultiple(c, func() models.CouncilRegistrationResponse {
+		return *gen.GenerateCouncilRegistration(council, state)
+	})
+}
+
 // generateCPFResponse creates the response structure for CPF
 func generateCPFResponse(gen generators.DocumentGenerator, formatted bool, valid bool) *models.CPFResponse {
 	cpf := gen.GenerateCPF(formatted, valid)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/diogomcd/fake-mill-api/internal/generators"
@@ -28,10 +29,16 @@ func setupDocumentsApp() *fiber.App {
 	v1.Get("/cpf", CPFHandler)
 	v1.Get("/cnpj", CNPJHandler)
 	v1.Get("/rg", RGHandler)
+	v1.Get("/certificate", CertificateHandler)
+	v1.Get("/passport", PassportHandler)
+	v1.Get("/council-registration", CouncilRegistrationHandler)
 	v1.Get("/validate/cpf/:cpf", ValidateCPFHandler)
 	v1.Get("/validate/cpf", ValidateCPFHandler)
 	v1.Get("/validate/cnpj/:cnpj", ValidateCNPJHandler)
 	v1.Get("/validate/rg/:rg", ValidateRGHandler)
+	v1.Get("/validate/certificate/:number", ValidateCertificateHandler)
+	v1.Get("/validate/passport/:passport", ValidatePassportHandler)
+	v1.Get("/validate/council-registration", ValidateCouncilRegistrationHandler)
 
 	return app
 }
@@ -152,6 +159,38 @@ func TestValidateCNPJHandler_Valid(t *testing.T) {
 	assert.True(t, validation.Valid)
 }
 
+func TestValidateCNPJHandler_Branch(t *testing.T) {
+	app := setupDocumentsApp()
+
+	tests := []struct {
+		branch       int
+		headquarters bool
+	}{
+		{1, true},
+		{12, false},
+	}
+
+	for _, tt := range tests {
+		cnpj := generators.BuildCNPJ("11222333", tt.branch)
+		req := httptest.NewRequest("GET", "/api/v1/validate/cnpj/"+cnpj, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var validation models.CNPJValidationResponse
+		err = json.Unmarshal(body, &validation)
+		assert.NoError(t, err)
+		assert.True(t, validation.Valid)
+		assert.Equal(t, "11222333", validation.Root)
+		assert.Equal(t, fmt.Sprintf("%04d", tt.branch), validation.Branch)
+		if assert.NotNil(t, validation.Headquarters) {
+			assert.Equal(t, tt.headquarters, *validation.Headquarters)
+		}
+	}
+}
+
 func TestValidateRGHandler_Valid(t *testing.T) {
 	ds, _ := generators.NewDataStore()
 	gen := generators.NewGenerator(ds)
@@ -170,3 +209,235 @@ func TestValidateRGHandler_Valid(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, validation.Valid)
 }
+
+func TestCertificateHandler_Birth(t *testing.T) {
+	app := setupDocumentsApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/certificate?state=PE&date=2012-03-04", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var certificate models.CertificateResponse
+	err = json.Unmarshal(body, &certificate)
+	assert.NoError(t, err)
+	assert.Equal(t, "birth", certificate.Type)
+	assert.Equal(t, "PE", certificate.State)
+	assert.Equal(t, "26", certificate.RegistryCNS[:2])
+	assert.Equal(t, "2012", certificate.Unmasked[10:14])
+	if assert.NotNil(t, certificate.Registrant) {
+		assert.Equal(t, "2012-03-04", certificate.Registrant.Birthdate)
+	}
+}
+
+func TestCertificateHandler_Marriage(t *testing.T) {
+	app := setupDocumentsApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/certificate?type=marriage&quantity=3", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var certificates []models.CertificateResponse
+	err = json.Unmarshal(body, &certificates)
+	assert.NoError(t, err)
+	assert.Len(t, certificates, 3)
+	for _, certificate := range certificates {
+		assert.Equal(t, "marriage", certificate.Type)
+		assert.Len(t, certificate.Spouses, 2)
+	}
+}
+
+func TestCertificateHandler_InvalidParameters(t *testing.T) {
+	app := setupDocumentsApp()
+
+	urls := []string{
+		"/api/v1/certificate?type=death",
+		"/api/v1/certificate?date=04/03/2012",
+		"/api/v1/certificate?date=1899-12-31",
+		"/api/v1/certificate?date=2999-01-01",
+		"/api/v1/certificate?distribution=popular",
+	}
+
+	for _, url := range urls {
+		req := httptest.NewRequest("GET", url, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 400, resp.StatusCode, url)
+
+		body, _ := io.ReadAll(resp.Body)
+		var errorResp map[string]string
+		_ = json.Unmarshal(body, &errorResp)
+		assert.Equal(t, "invalid_parameter", errorResp["code"], url)
+	}
+}
+
+func TestValidateCertificateHandler(t *testing.T) {
+	app := setupDocumentsApp()
+
+	tests := []struct {
+		number string
+		valid  bool
+		reason string
+	}{
+		{"12345601552015100012123000123413", true, ""},
+		{"123456%2001%2055%202015%201%2000012%20123%200001234%2013", true, ""},
+		{"12345601552015100012123000123400", false, generators.ErrCertificateCheckDigits.Error()},
+		{"123", false, generators.ErrCertificateLength.Error()},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/v1/validate/certificate/"+tt.number, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var validation models.CertificateValidationResponse
+		err = json.Unmarshal(body, &validation)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.valid, validation.Valid, tt.number)
+		assert.Equal(t, tt.reason, validation.Reason, tt.number)
+		if tt.valid {
+			assert.Equal(t, "123456 01 55 2015 1 00012 123 0001234 13", validation.Formatted)
+			assert.Equal(t, "birth", validation.Type)
+			assert.Equal(t, 2015, validation.Year)
+			assert.Equal(t, 1234, validation.Term)
+		}
+	}
+}
+
+func TestPassportHandler_Success(t *testing.T) {
+	app := setupDocumentsApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/passport?birthdate=2012-09-30", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var passport models.PassportResponse
+	err = json.Unmarshal(body, &passport)
+	assert.NoError(t, err)
+	assert.True(t, generators.ValidatePassport(passport.Number))
+	assert.Equal(t, "DPF", passport.Issuer)
+	assert.Equal(t, "2012-09-30", passport.HolderBirthdate)
+}
+
+func TestPassportHandler_InvalidBirthdate(t *testing.T) {
+	app := setupDocumentsApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/passport?birthdate=30-09-2012", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "birthdate")
+}
+
+func TestValidatePassportHandler(t *testing.T) {
+	app := setupDocumentsApp()
+
+	tests := []struct {
+		passport string
+		valid    bool
+	}{
+		{"GA123456", true},
+		{"G1234567", false},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/v1/validate/passport/"+tt.passport, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var validation models.PassportValidationResponse
+		err = json.Unmarshal(body, &validation)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.valid, validation.Valid, tt.passport)
+	}
+}
+
+func TestCouncilRegistrationHandler_Success(t *testing.T) {
+	app := setupDocumentsApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/council-registration?council=oab&state=MG", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var registration models.CouncilRegistrationResponse
+	err = json.Unmarshal(body, &registration)
+	assert.NoError(t, err)
+	assert.Equal(t, "OAB", registration.Council)
+	assert.Equal(t, "MG", registration.State)
+	assert.Regexp(t, `^OAB/MG \d{1,3}\.\d{3}$`, registration.Registration)
+	assert.True(t, generators.ValidateCouncilRegistration(registration.Registration))
+}
+
+func TestCouncilRegistrationHandler_InvalidCouncil(t *testing.T) {
+	app := setupDocumentsApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/council-registration?council=CRO", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "council")
+}
+
+func TestValidateCouncilRegistrationHandler(t *testing.T) {
+	app := setupDocumentsApp()
+
+	tests := []struct {
+		registration string
+		valid        bool
+		formatted    string
+	}{
+		{"CRM/SP 123456", true, "CRM/SP 123456"},
+		{"coren-rj 123456-enf", true, "COREN-RJ 123.456-ENF"},
+		{"CRM/XX 123456", false, ""},
+		{"CRO/SP 12345", false, ""},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/v1/validate/council-registration?registration="+url.QueryEscape(tt.registration), nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		body, _ := io.ReadAll(resp.Body)
+		var validation models.CouncilRegistrationValidationResponse
+		err = json.Unmarshal(body, &validation)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.valid, validation.Valid, tt.registration)
+		assert.Equal(t, tt.formatted, validation.Formatted, tt.registration)
+		if !tt.valid {
+			assert.NotEmpty(t, validation.Reason, tt.registration)
+		}
+	}
+}
+
+func TestValidateCouncilRegistrationHandler_Missing(t *testing.T) {
+	app := setupDocumentsApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/validate/council-registration", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "registration")
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/diogomcd/fake-mill-api/internal/generators"
+	"github.com/diogomcd/fake-mill-api/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// StatesHandler handles requests to the /api/v1/states endpoint
+// @Summary Lista os estados
+// @Description Lista os estados cobertos pela base de endereços reais, com código IBGE, região, DDD principal e quantidade de cidades.
+// @Tags Geografia
+// @Produce json
+// @Success 200 {array} models.StateResponse
+// @Router /states [get]
+func StatesHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+
+	log.Debug().
+		Str("handler", "StatesHandler").
+		Msg("States listing requested")
+
+	return c.JSON(gen.ListStates())
+}
+
+// CitiesHandler handles requests to the /api/v1/states/{uf}/cities endpoint
+// @Summary Lista as cidades de um estado
+// @Description Lista as cidades de um estado cobertas pela base de endereços reais, com código IBGE, DDD e quantidade de bairros e logradouros.
+// @Tags Geografia
+// @Produce json
+// @Param uf path string true "UF do estado (ex: SP, RJ)"
+// @Success 200 {array} models.CityResponse
+// @Failure 404 {object} map[string]string
+// @Router /states/{uf}/cities [get]
+func CitiesHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	uf := c.Params("uf")
+
+	cities, ok := gen.ListCities(uf)
+
+	log.Debug().
+		Str("handler", "CitiesHandler").
+		Str("state", uf).
+		Bool("found", ok).
+		Msg("Cities listing requested")
+
+	if !ok {
+		return notFound(c, fmt.Sprintf("state %s not found", uf), "state_not_found")
+	}
+	return c.JSON(cities)
+}
+
+// DistrictsHandler handles requests to the /api/v1/states/{uf}/cities/{city}/districts endpoint
+// @Summary Lista os bairros de uma cidade
+// @Description Lista os bairros de uma cidade cobertos pela base de endereços reais, com a quantidade de logradouros. A cidade ignora maiúsculas e acentos.
+// @Tags Geografia
+// @Produce json
+// @Param uf path string true "UF do estado (ex: SP, RJ)"
+// @Param city path string true "Nome da cidade (ex: Rio Branco, sao luis)"
+// @Success 200 {array} models.DistrictResponse
+// @Failure 404 {object} map[string]string
+// @Router /states/{uf}/cities/{city}/districts [get]
+func DistrictsHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	uf := c.Params("uf")
+	city := pathParam(c, "city")
+
+	districts, ok := gen.ListDistricts(uf, city)
+
+	log.Debug().
+		Str("handler", "DistrictsHandler").
+		Str("state", uf).
+		Str("city", city).
+		Bool("found", ok).
+		Msg("Districts listing requested")
+
+	if !ok {
+		return notFound(c, fmt.Sprintf("city %s not found in state %s", city, uf), "city_not_found")
+	}
+	return c.JSON(districts)
+}
+
+// StreetsHandler handles requests to the /api/v1/states/{uf}/cities/{city}/streets endpoint
+// @Summary Lista os logradouros de uma cidade
+// @Description Lista, com paginação, os logradouros reais de uma cidade em ordem alfabética, com tipo, CEP, bairro e coordenadas. Pode ser restrita a um bairro. A cidade e o bairro ignoram maiúsculas e acentos.
+// @Tags Geografia
+// @Produce json
+// @Param uf path string true "UF do estado (ex: SP, RJ)"
+// @Param city path string true "Nome da cidade (ex: Rio Branco, sao luis)"
+// @Param district query string false "Nome do bairro (ex: Centro)"
+// @Param page query int false "Página (a partir de 1)" minimum(1) default(1)
+// @Param page_size query int false "Logradouros por página (1-200)" minimum(1) maximum(200) default(50)
+// @Success 200 {object} models.StreetPage
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /states/{uf}/cities/{city}/streets [get]
+func StreetsHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	uf := c.Params("uf")
+	city := pathParam(c, "city")
+	district := c.Query("district", "")
+
+	page, err := parseOptionalInt(c, "page")
+	if err == nil && c.Query("page") != "" && page < 1 {
+		err = fmt.Errorf("page must be greater than or equal to 1")
+	}
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "StreetsHandler").
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid page parameter received")
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	pageSize, err := parseOptionalInt(c, "page_size")
+	if err == nil && c.Query("page_size") != "" && (pageSize < 1 || pageSize > generators.MaxStreetPageSize) {
+		err = fmt.Errorf("page_size must be between 1 and %d", generators.MaxStreetPageSize)
+	}
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "StreetsHandler").
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid page_size parameter received")
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	streets, ok := gen.ListStreets(uf, city, district, page, pageSize)
+
+	log.Debug().
+		Str("handler", "StreetsHandler").
+		Str("state", uf).
+		Str("city", city).
+		Str("district", district).
+		Int("page", page).
+		Int("page_size", pageSize).
+		Bool("found", ok).
+		Msg("Streets listing requested")
+
+	if !ok {
+		// The city exists, so the district is the one missing
+		if _, cityFound := gen.ListDistricts(uf, city); cityFound {
+			return notFound(c, fmt.Sprintf("district %s not found in city %s", district, city), "district_not_found")
+		}
+		return notFound(c, fmt.Sprintf("city %s not found in state %s", city, uf), "city_not_found")
+	}
+	return c.JSON(streets)
+}
+
+// pathParam returns a path parameter decoded from the URL encoding (ex: S%C3%A3o%20Lu%C3%ADs)
+func pathParam(c *fiber.Ctx, name string) string {
+	value, err := url.PathUnescape(c.Params(name))
+	if err != nil {
+		return c.Params(name)
+	}
+	return value
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/diogomcd/fake-mill-api/internal/generators"
+	"github.com/diogomcd/fake-mill-api/internal/handlers/testutils"
+	"github.com/diogomcd/fake-mill-api/internal/middleware"
+	"github.com/diogomcd/fake-mill-api/internal/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupGeographyApp() *fiber.App {
+	ds, err := generators.NewDataStore()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create DataStore: %v", err))
+	}
+	gen := generators.NewGenerator(ds)
+
+	app := fiber.New()
+	app.Use(middleware.InjectGenerator(gen))
+	v1 := app.Group("/api/v1")
+	v1.Get("/states", StatesHandler)
+	v1.Get("/states/:uf/cities", CitiesHandler)
+	v1.Get("/states/:uf/cities/:city/districts", DistrictsHandler)
+	v1.Get("/states/:uf/cities/:city/streets", StreetsHandler)
+
+	return app
+}
+
+func TestStatesHandler(t *testing.T) {
+	app := setupGeographyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/states", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var states []models.StateResponse
+	err = json.Unmarshal(body, &states)
+	assert.NoError(t, err)
+	assert.Len(t, states, 27)
+}
+
+func TestCitiesHandler(t *testing.T) {
+	app := setupGeographyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/states/ma/cities", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var cities []models.CityResponse
+	err = json.Unmarshal(body, &cities)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, cities)
+	for _, city := range cities {
+		assert.Equal(t, "MA", city.State)
+		assert.Len(t, city.IBGECode, 7)
+		assert.Len(t, city.DDD, 2)
+	}
+
+	req = httptest.NewRequest("GET", "/api/v1/states/XX/cities", nil)
+	resp, err = app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 404, "state")
+}
+
+func TestDistrictsHandler(t *testing.T) {
+	app := setupGeographyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/states/MA/cities/S%C3%A3o%20Lu%C3%ADs/districts", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var districts []models.DistrictResponse
+	err = json.Unmarshal(body, &districts)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, districts)
+
+	req = httptest.NewRequest("GET", "/api/v1/states/MA/cities/Cidade%20Inexistente/districts", nil)
+	resp, err = app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 404, "city")
+}
+
+func TestStreetsHandler(t *testing.T) {
+	app := setupGeographyApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/states/AC/cities/rio%20branco/streets?page=2&page_size=10", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var page models.StreetPage
+	err = json.Unmarshal(body, &page)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, page.Page)
+	assert.Equal(t, 10, page.PageSize)
+	assert.Equal(t, 24, page.Total)
+	assert.Len(t, page.Streets, 10)
+}
+
+func TestStreetsHandler_Errors(t *testing.T) {
+	app := setupGeographyApp()
+
+	tests := []struct {
+		path     string
+		status   int
+		contains string
+	}{
+		{"/api/v1/states/AC/cities/Rio%20Branco/streets?page=0", 400, "page"},
+		{"/api/v1/states/AC/cities/Rio%20Branco/streets?page_size=500", 400, "page_size"},
+		{"/api/v1/states/AC/cities/Rio%20Branco/streets?district=Inexistente", 404, "district"},
+		{"/api/v1/states/AC/cities/Manaus/streets", 404, "city"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", tt.path, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, tt.status, tt.contains)
+	}
+}
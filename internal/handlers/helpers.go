@@ -1,8 +1,13 @@
 package handlers
 
 import (
+	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/diogomcd/fake-mill-api/internal/generators"
 	"github.com/diogomcd/fake-mill-api/internal/middleware"
 	"github.com/diogomcd/fake-mill-api/pkg/logger"
 	"github.com/gofiber/fiber/v2"
@@ -12,6 +17,8 @@ import (
 const (
 	minQuantity = 1
 	maxQuantity = 200
+	// minDate is the earliest date accepted by date parameters
+	minDate = "1900-01-01"
 )
 
 func parseQuantity(quantityStr string) int {
@@ -38,6 +45,132 @@ func parseQuantity(quantityStr string) int {
 	return quantity
 }
 
+// parseOptionalFloat parses an optional non-negative decimal query parameter
+// Returns zero when the parameter is absent
+func parseOptionalFloat(c *fiber.Ctx, name string) (float64, error) {
+	raw := c.Query(name, "")
+	if raw == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative number", name)
+	}
+
+	return value, nil
+}
+
+// parseLocationOptions parses the optional city, region and capital query parameters in the (already sanitized) state
+// The options are normalized, so a city fills an empty state and constraints no known city satisfies are rejected
+func parseLocationOptions(c *fiber.Ctx, gen generators.IGenerator, handler, state string) (generators.LocationOptions, error) {
+	capital, err := parseOptionalBool(c, "capital")
+	if err != nil {
+		return generators.LocationOptions{}, err
+	}
+
+	opts, err := gen.NormalizeLocationOptions(generators.LocationOptions{
+		State:   state,
+		City:    strings.TrimSpace(c.Query("city", "")),
+		Region:  strings.TrimSpace(c.Query("region", "")),
+		Capital: capital,
+	})
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", handler).
+			Str("state", state).
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid location received")
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+// parseOptionalInt parses an optional non-negative integer query parameter
+// Returns zero when the parameter is absent
+func parseOptionalInt(c *fiber.Ctx, name string) (int, error) {
+	raw := c.Query(name, "")
+	if raw == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer", name)
+	}
+
+	return value, nil
+}
+
+// parseOptionalBool parses an optional boolean query parameter
+// Returns nil when the parameter is absent
+func parseOptionalBool(c *fiber.Ctx, name string) (*bool, error) {
+	raw := c.Query(name, "")
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be true or false", name)
+	}
+
+	return &value, nil
+}
+
+// parseCoordinate parses a required latitude or longitude query parameter between -limit and limit degrees
+func parseCoordinate(c *fiber.Ctx, name string, limit float64) (float64, error) {
+	value, err := strconv.ParseFloat(c.Query(name, ""), 64)
+	if err != nil || math.IsNaN(value) || value < -limit || value > limit {
+		return 0, fmt.Errorf("%s must be a number between %g and %g", name, -limit, limit)
+	}
+
+	return value, nil
+}
+
+// parseOptionalDate parses an optional YYYY-MM-DD query parameter between 1900-01-01 and today
+// Returns an empty string when the parameter is absent
+func parseOptionalDate(c *fiber.Ctx, name string) (string, error) {
+	raw := c.Query(name, "")
+	if raw == "" {
+		return "", nil
+	}
+
+	date, err := time.Parse("2006-01-02", raw)
+	if err != nil || raw < minDate || date.After(time.Now()) {
+		return "", fmt.Errorf("%s must be a date in YYYY-MM-DD format between %s and today", name, minDate)
+	}
+
+	return raw, nil
+}
+
+// parseNameDistribution parses the optional distribution query parameter
+// Returns the realistic distribution when the parameter is absent
+func parseNameDistribution(c *fiber.Ctx) (generators.NameDistribution, error) {
+	distribution := generators.NameDistribution(c.Query("distribution", string(generators.NameDistributionRealistic)))
+	if distribution != generators.NameDistributionRealistic && distribution != generators.NameDistributionUniform {
+		return "", fmt.Errorf("distribution must be uniform or realistic")
+	}
+	return distribution, nil
+}
+
+// badRequest sends a 400 response with the standard error body
+func badRequest(c *fiber.Ctx, message, code string) error {
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": message,
+		"code":  code,
+	})
+}
+
+func notFound(c *fiber.Ctx, message, code string) error {
+	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+		"error": message,
+		"code":  code,
+	})
+}
+
 func generateMultiple[T any](c *fiber.Ctx, generator func() T) error {
 	quantity := parseQuantity(c.Query("quantity", "1"))
 
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/diogomcd/fake-mill-api/internal/generators"
+	"github.com/diogomcd/fake-mill-api/internal/middleware"
+	"github.com/diogomcd/fake-mill-api/internal/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// HouseholdHandler handles requests to the /api/v1/household endpoint
+// @Summary Gera uma família fictícia
+// @Description Gera um ou mais núcleos familiares (responsável, cônjuge, filhos e opcionalmente os pais do responsável) morando no mesmo endereço, com sobrenomes, idades, estado civil e filiação consistentes.
+// @Tags Pessoa
+// @Accept json
+// @Produce json
+// @Param quantity query int false "Quantidade de famílias (1-200)" minimum(1) maximum(200) default(1)
+// @Param state query string false "UF do estado (ex: SP, RJ)"
+// @Param children query int false "Quantidade de filhos (0-8), aleatório se omitido" minimum(0) maximum(8)
+// @Param with_parents query bool false "Inclui os pais do responsável pela família" default(false)
+// @Param distribution query string false "Distribuição dos nomes: realistic segue a frequência na população, uniform sorteia com a mesma chance" Enums(realistic, uniform) default(realistic)
+// @Success 200 {object} models.Household
+// @Success 200 {array} models.Household
+// @Failure 400 {object} map[string]string
+// @Router /household [get]
+func HouseholdHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+	state := c.Query("state", "")
+	withParents, _ := strconv.ParseBool(c.Query("with_parents", "false"))
+
+	originalState := state
+	state = gen.GetDataStore().ValidateAndSanitizeState(state)
+
+	if originalState != "" && state == "" {
+		log.Warn().
+			Str("handler", "HouseholdHandler").
+			Str("requested_state", originalState).
+			Str("error_type", "invalid_state_code").
+			Msg("Invalid state code provided, using random state")
+	}
+
+	children := -1
+	if raw := c.Query("children", ""); raw != "" {
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 0 || value > generators.MaxHouseholdChildren {
+			log.Warn().
+				Str("handler", "HouseholdHandler").
+				Str("children", raw).
+				Str("error_type", "invalid_parameter").
+				Msg("Invalid children parameter received")
+			return badRequest(c, fmt.Sprintf("children must be an integer between 0 and %d", generators.MaxHouseholdChildren), "invalid_parameter")
+		}
+		children = value
+	}
+
+	distribution, err := parseNameDistribution(c)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "HouseholdHandler").
+			Str("error_type", "invalid_parameter").
+			Msg("Invalid distribution parameter received")
+		return badRequest(c, err.Error(), "invalid_parameter")
+	}
+
+	opts := generators.HouseholdOptions{
+		State:        state,
+		Children:     children,
+		WithParents:  withParents,
+		Distribution: distribution,
+	}
+
+	log.Debug().
+		Str("handler", "HouseholdHandler").
+		Str("state", state).
+		Int("children", children).
+		Bool("with_parents", withParents).
+		Str("distribution", string(distribution)).
+		Msg("Household generation requested")
+
+	return generateMultiple(c, func() models.Household {
+		return *gen.GenerateHousehold(opts)
+	})
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/diogomcd/fake-mill-api/internal/generators"
+	"github.com/diogomcd/fake-mill-api/internal/handlers/testutils"
+	"github.com/diogomcd/fake-mill-api/internal/middleware"
+	"github.com/diogomcd/fake-mill-api/internal/models"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupHouseholdApp() *fiber.App {
+	ds, err := generators.NewDataStore()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create DataStore: %v", err))
+	}
+	gen := generators.NewGenerator(ds)
+
+	app := fiber.New()
+	app.Use(middleware.InjectGenerator(gen))
+	v1 := app.Group("/api/v1")
+	v1.Get("/household", HouseholdHandler)
+
+	return app
+}
+
+func TestHouseholdHandler_Success(t *testing.T) {
+	app := setupHouseholdApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/household?children=2&state=SP", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	body, _ := io.ReadAll(resp.Body)
+	var household models.Household
+	err = json.Unmarshal(body, &household)
+	assert.NoError(t, err)
+	assert.Len(t, household.Members, 4)
+	assert.Equal(t, "SP", household.Address.State)
+}
+
+func TestHouseholdHandler_Quantity(t *testing.T) {
+	app := setupHouseholdApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/household?quantity=3&with_parents=true", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var households []models.Household
+	err = json.Unmarshal(body, &households)
+	assert.NoError(t, err)
+	assert.Len(t, households, 3)
+}
+
+func TestHouseholdHandler_Error_InvalidChildren(t *testing.T) {
+	app := setupHouseholdApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/household?children=20", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "children")
+}
+
+func TestHouseholdHandler_Error_InvalidDistribution(t *testing.T) {
+	app := setupHouseholdApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/household?distribution=gaussian", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "distribution")
+}
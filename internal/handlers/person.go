@@ -1,6 +1,12 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/diogomcd/fake-mill-api/internal/generators"
 	"github.com/diogomcd/fake-mill-api/internal/middleware"
 	"github.com/diogomcd/fake-mill-api/internal/models"
 	"github.com/gofiber/fiber/v2"
@@ -9,22 +15,43 @@ import (
 
 // PersonHandler handles requests to the /api/v1/person endpoint
 // @Summary Gera dados de uma pessoa
-// @Description Gera um ou mais registros de pessoas fictícias com nome, CPF, RG, data de nascimento, etc.
+// @Description Gera um ou mais registros de pessoas fictícias com nome, CPF, RG, data de nascimento, etc. Menores de idade não possuem profissão, empresa nem estado civil. Estrangeiros residentes também possuem CPF e endereço no Brasil.
 // @Tags Pessoa
 // @Accept json
 // @Produce json
 // @Param quantity query int false "Quantidade de registros (1-200)" minimum(1) maximum(200) default(1)
-// @Param gender query string false "Gênero da pessoa" Enums(male, female, random)
+// @Param gender query string false "Gênero da pessoa. non-binary gera apenas adultos" Enums(male, female, non-binary, random)
 // @Param state query string false "UF do estado (ex: SP, RJ)"
+// @Param city query string false "Cidade do endereço, sem diferenciar maiúsculas e acentos (ex: sao paulo). Deve existir no estado informado"
+// @Param region query string false "Região do endereço" Enums(Norte, Nordeste, Centro-Oeste, Sudeste, Sul)
+// @Param capital query bool false "Endereço somente em capitais (true) ou somente no interior (false). Apenas capitais presentes na base de endereços são sorteadas"
+// @Param min_age query int false "Idade mínima (0-100). Padrão 18, ou 0 quando max_age for menor que 18" minimum(0) maximum(100)
+// @Param max_age query int false "Idade máxima (1-100). Menores de idade incluem certidão de nascimento e responsável legal" minimum(1) maximum(100) default(80)
+// @Param profession_area query string false "Área da profissão (ex: Tecnologia, Saúde)"
+// @Param education query string false "Escolaridade (ex: Ensino Superior Completo)"
+// @Param marital_status query string false "Estado civil" Enums(single, married, divorced, widowed)
+// @Param blood_type query string false "Tipo sanguíneo (ex: O+, AB-)"
+// @Param birth_state query string false "UF de nascimento (ex: BA)"
+// @Param nationality query string false "Nacionalidade: BR, foreign (país sorteado pela proporção de imigrantes) ou código ISO do país (ex: VE, HT, AR). Estrangeiros são adultos com RNM/CRNM, passaporte e data de entrada no lugar do RG" default(BR)
+// @Param has_company query bool false "Força a presença (true) ou ausência (false) de empresa"
+// @Param min_salary query number false "Salário mínimo em reais (ex: 3000)"
+// @Param max_salary query number false "Salário máximo em reais (ex: 8000)"
+// @Param distribution query string false "Distribuição dos nomes: realistic segue a frequência na população, uniform sorteia com a mesma chance" Enums(realistic, uniform) default(realistic)
+// @Param diversity_rate query number false "Proporção (0 a 1) de adultos com gênero diferente do sexo de nascimento (pessoas trans e não binárias), que recebem nome social" minimum(0) maximum(1) default(0)
+// @Param passport query bool false "Inclui passaporte brasileiro válido (estrangeiros sempre trazem o passaporte do seu país)" default(false)
+// @Param persona query bool false "Inclui cartão de crédito, conta bancária e redes sociais derivados da pessoa" default(false)
 // @Success 200 {object} models.Person
 // @Success 200 {array} models.Person
+// @Failure 400 {object} map[string]string
 // @Router /person [get]
 func PersonHandler(c *fiber.Ctx) error {
 	gen := middleware.GetGenerator(c)
 	gender := c.Query("gender", "")
 	state := c.Query("state", "")
+	persona, _ := strconv.ParseBool(c.Query("persona", "false"))
+	passport, _ := strconv.ParseBool(c.Query("passport", "false"))
 
-	if gender != "" && gender != "male" && gender != "female" && gender != "random" {
+	if gender != "" && gender != "random" && !generators.IsValidGender(gender) {
 		log.Warn().
 			Str("handler", "PersonHandler").
 			Str("requested_gender", gender).
@@ -48,13 +75,125 @@ func PersonHandler(c *fiber.Ctx) error {
 			Msg("Invalid state code provided, using random state")
 	}
 
+	minSalary, err := parseOptionalFloat(c, "min_salary")
+	if err != nil {
+		return invalidPersonParameter(c, "min_salary", err)
+	}
+
+	maxSalary, err := parseOptionalFloat(c, "max_salary")
+	if err != nil {
+		return invalidPersonParameter(c, "max_salary", err)
+	}
+
+	if minSalary > 0 && maxSalary > 0 && minSalary > maxSalary {
+		return invalidPersonParameter(c, "min_salary", fmt.Errorf("min_salary must be less than or equal to max_salary"))
+	}
+
+	distribution, err := parseNameDistribution(c)
+	if err != nil {
+		return invalidPersonParameter(c, "distribution", err)
+	}
+
+	minAge, err := parseOptionalInt(c, "min_age")
+	if err != nil {
+		return invalidPersonParameter(c, "min_age", err)
+	}
+
+	maxAge, err := parseOptionalInt(c, "max_age")
+	if err != nil {
+		return invalidPersonParameter(c, "max_age", err)
+	}
+	// An absent max_age parses as zero and falls back to the defaults, an explicit one must be at least 1
+	if c.Query("max_age", "") != "" && maxAge < 1 {
+		return invalidPersonParameter(c, "max_age", fmt.Errorf("max_age must be between 1 and %d", generators.MaxPersonAge))
+	}
+
+	// Without min_age, minors are only included when max_age asks for them
+	if c.Query("min_age", "") == "" && maxAge >= generators.AdultAge {
+		minAge = generators.DefaultMinAge
+	}
+
+	hasCompany, err := parseOptionalBool(c, "has_company")
+	if err != nil {
+		return invalidPersonParameter(c, "has_company", err)
+	}
+
+	diversityRate, err := parseOptionalFloat(c, "diversity_rate")
+	if err != nil {
+		return invalidPersonParameter(c, "diversity_rate", err)
+	}
+
+	capital, err := parseOptionalBool(c, "capital")
+	if err != nil {
+		return invalidPersonParameter(c, "capital", err)
+	}
+
+	opts, err := gen.NormalizePersonOptions(generators.PersonOptions{
+		Gender:         gender,
+		State:          state,
+		City:           strings.TrimSpace(c.Query("city", "")),
+		Region:         strings.TrimSpace(c.Query("region", "")),
+		Capital:        capital,
+		MinAge:         minAge,
+		MaxAge:         maxAge,
+		MinSalary:      minSalary,
+		MaxSalary:      maxSalary,
+		ProfessionArea: c.Query("profession_area", ""),
+		Education:      c.Query("education", ""),
+		MaritalStatus:  c.Query("marital_status", ""),
+		// The + of blood types arrives as a space when not percent-encoded
+		BloodType:     strings.ReplaceAll(c.Query("blood_type", ""), " ", "+"),
+		BirthState:    c.Query("birth_state", ""),
+		Nationality:   c.Query("nationality", ""),
+		Passport:      passport,
+		HasCompany:    hasCompany,
+		DiversityRate: diversityRate,
+		Persona:       persona,
+		Distribution:  distribution,
+	})
+	if err != nil {
+		parameter := "person"
+		var optionErr *generators.PersonOptionError
+		if errors.As(err, &optionErr) {
+			parameter = optionErr.Parameter
+		}
+		return invalidPersonParameter(c, parameter, err)
+	}
+
 	log.Debug().
 		Str("handler", "PersonHandler").
 		Str("gender", gender).
-		Str("state", state).
+		Str("state", opts.State).
+		Str("city", opts.City).
+		Str("region", opts.Region).
+		Int("min_age", opts.MinAge).
+		Int("max_age", opts.MaxAge).
+		Float64("min_salary", minSalary).
+		Float64("max_salary", maxSalary).
+		Str("profession_area", opts.ProfessionArea).
+		Str("education", opts.Education).
+		Str("marital_status", opts.MaritalStatus).
+		Str("blood_type", opts.BloodType).
+		Str("birth_state", opts.BirthState).
+		Str("nationality", opts.Nationality).
+		Float64("diversity_rate", diversityRate).
+		Bool("passport", passport).
+		Bool("persona", persona).
+		Str("distribution", string(distribution)).
 		Msg("Person generation requested")
 
 	return generateMultiple(c, func() models.Person {
-		return *gen.GeneratePerson(gender, state)
+		return *gen.GeneratePersonWithOptions(opts)
 	})
 }
+
+// invalidPersonParameter logs and rejects an invalid person parameter
+func invalidPersonParameter(c *fiber.Ctx, parameter string, err error) error {
+	log.Warn().
+		Err(err).
+		Str("handler", "PersonHandler").
+		Str("parameter", parameter).
+		Str("error_type", "invalid_parameter").
+		Msg("Invalid person parameter received")
+	return badRequest(c, err.Error(), "invalid_parameter")
+}
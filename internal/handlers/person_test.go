@@ -171,3 +171,292 @@ func TestPersonHandler_Error_InvalidState(t *testing.T) {
 	err = json.Unmarshal(body, &person)
 	assert.NoError(t, err)
 }
+
+func TestPersonHandler_Success_SalaryRange(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?min_salary=4000&max_salary=6000&quantity=10", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var people []models.Person
+	err = json.Unmarshal(body, &people)
+	assert.NoError(t, err)
+	for _, person := range people {
+		assert.GreaterOrEqual(t, person.Profession.SalaryValue, 4000.0)
+		assert.LessOrEqual(t, person.Profession.SalaryValue, 6000.0)
+		assert.Contains(t, person.Profession.Salary, "R$ ")
+	}
+}
+
+func TestPersonHandler_Error_InvalidSalaryRange(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?min_salary=9000&max_salary=3000", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "min_salary")
+}
+
+func TestPersonHandler_Error_NonNumericSalary(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?max_salary=abc", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "max_salary")
+}
+
+func TestPersonHandler_Success_Persona(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?persona=true&gender=female", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var person models.Person
+	err = json.Unmarshal(body, &person)
+	assert.NoError(t, err)
+	assert.NotNil(t, person.CreditCard)
+	assert.NotNil(t, person.BankAccount)
+	assert.NotNil(t, person.Social)
+	assert.Equal(t, person.Name.FullName, person.BankAccount.HolderName)
+}
+
+func TestPersonHandler_Success_UniformDistribution(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?distribution=uniform", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+}
+
+func TestPersonHandler_Error_InvalidDistribution(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?distribution=gaussian", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "distribution")
+}
+
+func TestPersonHandler_Success_Constraints(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?min_age=40&max_age=45&profession_area=Sa%C3%BAde&education=Ensino%20Superior%20Completo&marital_status=widowed&blood_type=AB+&birth_state=RS&has_company=false", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var person models.Person
+	err = json.Unmarshal(body, &person)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, person.Age, 40)
+	assert.LessOrEqual(t, person.Age, 45)
+	assert.Equal(t, "Saúde", person.Profession.Area)
+	assert.Equal(t, "Ensino Superior Completo", person.Education)
+	assert.Equal(t, "widowed", person.MaritalStatus)
+	assert.Equal(t, "AB+", person.BloodType)
+	assert.Equal(t, "RS", person.BirthState)
+	assert.Nil(t, person.Company)
+}
+
+func TestPersonHandler_Error_InvalidConstraints(t *testing.T) {
+	app, _ := setupTestApp()
+
+	tests := []struct {
+		query     string
+		parameter string
+	}{
+		{"min_age=60&max_age=30", "min_age"},
+		{"min_age=abc", "min_age"},
+		{"max_age=0", "max_age"},
+		{"max_age=-5", "max_age"},
+		{"max_age=20&education=Doutorado", "education"},
+		{"marital_status=engaged", "marital_status"},
+		{"blood_type=Z", "blood_type"},
+		{"profession_area=Magia", "profession_area"},
+		{"birth_state=XX", "birth_state"},
+		{"has_company=maybe", "has_company"},
+		{"diversity_rate=2", "diversity_rate"},
+		{"diversity_rate=-0.5", "diversity_rate"},
+		{"gender=non-binary&max_age=15", "gender"},
+		{"nationality=XX", "nationality"},
+		{"nationality=foreign&birth_state=SP", "birth_state"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/api/v1/person?"+tt.query, nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		testutils.AssertHTTPError(t, resp, 400, tt.parameter)
+	}
+}
+
+func TestPersonHandler_Success_Minors(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?max_age=12&quantity=20", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var persons []models.Person
+	err = json.Unmarshal(body, &persons)
+	assert.NoError(t, err)
+	assert.Len(t, persons, 20)
+
+	for _, person := range persons {
+		assert.LessOrEqual(t, person.Age, 12)
+		assert.Nil(t, person.Profession)
+		assert.NotNil(t, person.BirthCertificate)
+		assert.NotNil(t, person.Guardian)
+	}
+}
+
+func TestPersonHandler_Error_AdultOnlyConstraintForMinors(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?max_age=15&profession_area=Tecnologia", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "profession_area")
+}
+
+func TestPersonHandler_Success_NonBinary(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?gender=non-binary&quantity=10", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var persons []models.Person
+	err = json.Unmarshal(body, &persons)
+	assert.NoError(t, err)
+
+	for _, person := range persons {
+		assert.Equal(t, "non-binary", person.Gender)
+		assert.Equal(t, "elu/delu", person.Pronouns)
+		assert.Contains(t, []string{"male", "female"}, person.SexAtBirth)
+		assert.NotNil(t, person.SocialName)
+		assert.GreaterOrEqual(t, person.Age, 18)
+	}
+}
+
+func TestPersonHandler_Success_DiversityRate(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?gender=female&diversity_rate=1&quantity=10", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var persons []models.Person
+	err = json.Unmarshal(body, &persons)
+	assert.NoError(t, err)
+
+	for _, person := range persons {
+		assert.Equal(t, "female", person.Gender)
+		assert.Equal(t, "male", person.SexAtBirth)
+		assert.Equal(t, "ela/dela", person.Pronouns)
+		assert.NotNil(t, person.SocialName)
+	}
+}
+
+func TestPersonHandler_Success_ForeignNationality(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?nationality=ve&state=RR&quantity=5", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var persons []models.Person
+	err = json.Unmarshal(body, &persons)
+	assert.NoError(t, err)
+
+	for _, person := range persons {
+		assert.Equal(t, "VE", person.Nationality)
+		assert.Equal(t, "RR", person.Address.State)
+		assert.Nil(t, person.RG)
+		if assert.NotNil(t, person.ForeignResident) {
+			assert.Equal(t, "Venezuela", person.ForeignResident.CountryOfBirth)
+			assert.NotEmpty(t, person.ForeignResident.RNM.Masked)
+			assert.Equal(t, "VE", person.ForeignResident.Passport.Country)
+		}
+	}
+}
+
+func TestPersonHandler_City(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?city=CAMPO%20LARGO&quantity=3", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var people []models.Person
+	err = json.Unmarshal(body, &people)
+	assert.NoError(t, err)
+	for _, person := range people {
+		assert.Equal(t, "Campo Largo", person.Address.City)
+		assert.Equal(t, "PR", person.Address.State)
+	}
+
+	req = httptest.NewRequest("GET", "/api/v1/person?state=BA&city=Campo%20Largo", nil)
+	resp, err = app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "city")
+}
+
+func TestPersonHandler_RegionAndCapital(t *testing.T) {
+	app, _ := setupTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/person?region=Nordeste&capital=false&quantity=5", nil)
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var people []models.Person
+	err = json.Unmarshal(body, &people)
+	assert.NoError(t, err)
+	for _, person := range people {
+		assert.Contains(t, []string{"AL", "BA", "CE", "MA", "PB", "PE", "PI", "RN", "SE"}, person.Address.State)
+		assert.NotEmpty(t, person.Address.IBGECode)
+	}
+
+	req = httptest.NewRequest("GET", "/api/v1/person?city=Natal&capital=false", nil)
+	resp, err = app.Test(req)
+
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "capital")
+}
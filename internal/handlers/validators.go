@@ -1,10 +1,13 @@
 package handlers
 
 import (
+	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 
 	"github.com/diogomcd/fake-mill-api/internal/generators"
+	"github.com/diogomcd/fake-mill-api/internal/middleware"
 	"github.com/diogomcd/fake-mill-api/internal/models"
 	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog/log"
@@ -184,7 +187,7 @@ func ValidateCPFHandler(c *fiber.Ctx) error {
 
 // ValidateCNPJHandler validates a CNPJ
 // @Summary Valida CNPJ
-// @Description Verifica se um número de CNPJ é válido de acordo com o algoritmo oficial.
+// @Description Verifica se um número de CNPJ é válido de acordo com o algoritmo oficial. Quando válido, informa a raiz (8 primeiros dígitos), o número do estabelecimento e se é a matriz (0001).
 // @Tags Validação
 // @Accept json
 // @Produce json
@@ -206,17 +209,27 @@ func ValidateCNPJHandler(c *fiber.Ctx) error {
 		})
 	}
 
-	isValid := generators.ValidateCNPJ(cnpj)
+	parsed := generators.ParseCNPJ(cnpj)
 
 	log.Debug().
 		Str("handler", "ValidateCNPJHandler").
 		Str("cnpj", cnpj).
-		Bool("is_valid", isValid).
+		Bool("is_valid", parsed != nil).
 		Msg("CNPJ validation processed")
 
+	if parsed == nil {
+		return c.JSON(models.CNPJValidationResponse{
+			CNPJ:  cnpj,
+			Valid: false,
+		})
+	}
+
 	return c.JSON(models.CNPJValidationResponse{
-		CNPJ:  cnpj,
-		Valid: isValid,
+		CNPJ:         cnpj,
+		Valid:        true,
+		Root:         parsed.Root,
+		Branch:       fmt.Sprintf("%04d", parsed.Branch),
+		Headquarters: &parsed.Headquarters,
 	})
 }
 
@@ -257,3 +270,184 @@ func ValidateRGHandler(c *fiber.Ctx) error {
 		Valid: isValid,
 	})
 }
+
+// ValidatePassportHandler validates a Brazilian passport number
+// @Summary Valida passaporte
+// @Description Verifica se um número de passaporte brasileiro tem o formato correto (duas letras e seis dígitos).
+// @Tags Validação
+// @Accept json
+// @Produce json
+// @Param passport path string true "Número do passaporte a validar (ex: FZ123456)"
+// @Success 200 {object} models.PassportValidationResponse
+// @Failure 400 {object} map[string]string
+// @Router /validate/passport/{passport} [get]
+func ValidatePassportHandler(c *fiber.Ctx) error {
+	passport := c.Params("passport")
+
+	if passport == "" {
+		log.Warn().
+			Str("handler", "ValidatePassportHandler").
+			Str("error_type", "missing_required_parameter").
+			Msg("passport parameter is required")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "passport parameter is required",
+			"code":  "missing_required_parameter",
+		})
+	}
+
+	isValid := generators.ValidatePassport(passport)
+
+	log.Debug().
+		Str("handler", "ValidatePassportHandler").
+		Str("passport", passport).
+		Bool("is_valid", isValid).
+		Msg("Passport validation processed")
+
+	return c.JSON(models.PassportValidationResponse{
+		Passport: passport,
+		Valid:    isValid,
+	})
+}
+
+// ValidateCertificateHandler validates a civil registry certificate number
+// @Summary Valida matrícula de certidão
+// @Description Verifica se a matrícula de uma certidão do registro civil tem 32 dígitos, serviço 55, ano e tipo de livro válidos e dígitos verificadores corretos, e retorna suas partes.
+// @Tags Validação
+// @Accept json
+// @Produce json
+// @Param number path string true "Matrícula a validar (com ou sem formatação)"
+// @Success 200 {object} models.CertificateValidationResponse
+// @Failure 400 {object} map[string]string
+// @Router /validate/certificate/{number} [get]
+func ValidateCertificateHandler(c *fiber.Ctx) error {
+	number, err := url.PathUnescape(c.Params("number"))
+	if err != nil {
+		number = c.Params("number")
+	}
+
+	if number == "" {
+		log.Warn().
+			Str("handler", "ValidateCertificateHandler").
+			Str("error_type", "missing_required_parameter").
+			Msg("number parameter is required")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "number parameter is required",
+			"code":  "missing_required_parameter",
+		})
+	}
+
+	certificate, err := generators.ParseCertificateNumber(number)
+
+	log.Debug().
+		Str("handler", "ValidateCertificateHandler").
+		Str("number", number).
+		Bool("is_valid", err == nil).
+		Msg("Certificate validation processed")
+
+	if err != nil {
+		return c.JSON(models.CertificateValidationResponse{
+			Number: number,
+			Valid:  false,
+			Reason: err.Error(),
+		})
+	}
+
+	return c.JSON(models.CertificateValidationResponse{
+		Number:      number,
+		Valid:       true,
+		Formatted:   generators.FormatCertificateNumber(certificate.Unmasked),
+		Type:        certificate.Type,
+		RegistryCNS: certificate.RegistryCNS,
+		Year:        certificate.Year,
+		Book:        certificate.Book,
+		Page:        certificate.Page,
+		Term:        certificate.Term,
+	})
+}
+
+// ValidateCouncilRegistrationHandler validates a professional council registration
+// @Summary Valida registro em conselho profissional
+// @Description Verifica se um registro profissional segue o formato do seu conselho (CRM, OAB, CREA, CRC ou COREN) e retorna as partes do registro quando válido.
+// @Tags Validação
+// @Accept json
+// @Produce json
+// @Param registration query string true "Registro a validar (ex: CRM/SP 123456, OAB/RJ 123.456, COREN-MG 123.456-ENF)"
+// @Success 200 {object} models.CouncilRegistrationValidationResponse
+// @Failure 400 {object} map[string]string
+// @Router /validate/council-registration [get]
+func ValidateCouncilRegistrationHandler(c *fiber.Ctx) error {
+	registration := c.Query("registration")
+
+	if strings.TrimSpace(registration) == "" {
+		log.Warn().
+			Str("handler", "ValidateCouncilRegistrationHandler").
+			Str("error_type", "missing_required_parameter").
+			Msg("registration parameter is required")
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "registration parameter is required",
+			"code":  "missing_required_parameter",
+		})
+	}
+
+	parsed, err := generators.ParseCouncilRegistration(registration)
+
+	log.Debug().
+		Str("handler", "ValidateCouncilRegistrationHandler").
+		Str("registration", registration).
+		Bool("is_valid", err == nil).
+		Msg("Council registration validation processed")
+
+	if err != nil {
+		return c.JSON(models.CouncilRegistrationValidationResponse{
+			Registration: registration,
+			Valid:        false,
+			Reason:       err.Error(),
+		})
+	}
+
+	return c.JSON(models.CouncilRegistrationValidationResponse{
+		Registration: registration,
+		Valid:        true,
+		Formatted:    generators.FormatCouncilRegistration(parsed),
+		Council:      parsed.Council,
+		State:        parsed.State,
+		Number:       parsed.Number,
+		Category:     parsed.Category,
+	})
+}
+
+// ValidateAddressHandler validates the consistency of an address
+// @Summary Valida consistência de endereço
+// @Description Verifica se CEP, cidade, UF e, opcionalmente, o DDD do telefone de um endereço concordam entre si: formato do CEP e faixa de CEP da UF, cidade existente na UF segundo a base de endereços e DDD do telefone igual ao da cidade (ou a um dos DDDs da UF quando a cidade não é conhecida). Cada campo recebe um resultado com código de motivo (ok, missing, invalid_format, unknown_state, unknown_range, state_mismatch, city_mismatch, city_not_found, ddd_mismatch ou unchecked).
+// @Tags Validação
+// @Accept json
+// @Produce json
+// @Param address body models.AddressValidationRequest true "Endereço a validar"
+// @Success 200 {object} models.AddressValidationResponse
+// @Failure 400 {object} map[string]string
+// @Router /validate/address [post]
+func ValidateAddressHandler(c *fiber.Ctx) error {
+	gen := middleware.GetGenerator(c)
+
+	var req models.AddressValidationRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Warn().
+			Err(err).
+			Str("handler", "ValidateAddressHandler").
+			Str("error_type", "invalid_body").
+			Msg("Invalid address body received")
+		return badRequest(c, "body must be a JSON object with zipcode, city, state and optional phone", "invalid_body")
+	}
+
+	response := gen.ValidateAddress(req)
+
+	log.Debug().
+		Str("handler", "ValidateAddressHandler").
+		Str("zipcode", req.Zipcode).
+		Str("state", req.State).
+		Str("city", req.City).
+		Bool("is_valid", response.Valid).
+		Msg("Address validation processed")
+
+	return c.JSON(response)
+}
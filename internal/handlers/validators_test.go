@@ -4,8 +4,12 @@ import (
 	"encoding/json"
 	"io"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/diogomcd/fake-mill-api/internal/generators"
+	"github.com/diogomcd/fake-mill-api/internal/handlers/testutils"
+	"github.com/diogomcd/fake-mill-api/internal/middleware"
 	"github.com/diogomcd/fake-mill-api/internal/models"
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
@@ -121,3 +125,52 @@ func TestValidatePhone(t *testing.T) {
 		})
 	}
 }
+
+func setupValidateAddressApp(t *testing.T) *fiber.App {
+	t.Helper()
+	ds, err := generators.NewDataStore()
+	if err != nil {
+		t.Fatalf("Failed to create DataStore: %v", err)
+	}
+
+	app := fiber.New()
+	app.Use(middleware.InjectGenerator(generators.NewGenerator(ds)))
+	app.Post("/validate/address", ValidateAddressHandler)
+	return app
+}
+
+func TestValidateAddressHandler(t *testing.T) {
+	app := setupValidateAddressApp(t)
+
+	body := `{"zipcode": "69918-111", "city": "Rio Branco", "state": "AC", "phone": "(11) 99999-1234"}`
+	req := httptest.NewRequest("POST", "/validate/address", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	testutils.AssertJSONHeaders(t, resp)
+
+	var response models.AddressValidationResponse
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.False(t, response.Valid)
+	if assert.Len(t, response.Findings, 4) {
+		assert.Equal(t, "ok", response.Findings[0].Code)
+		assert.Equal(t, "ok", response.Findings[1].Code)
+		assert.Equal(t, "ok", response.Findings[2].Code)
+		assert.Equal(t, "phone", response.Findings[3].Field)
+		assert.Equal(t, "ddd_mismatch", response.Findings[3].Code)
+		assert.Equal(t, "68", response.Findings[3].Expected)
+	}
+}
+
+func TestValidateAddressHandler_InvalidBody(t *testing.T) {
+	app := setupValidateAddressApp(t)
+
+	req := httptest.NewRequest("POST", "/validate/address", strings.NewReader(`{"zipcode": `))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	testutils.AssertHTTPError(t, resp, 400, "body must be a JSON object")
+}
@@ -1,15 +1,29 @@
 package models
 
 // Address represents a Brazilian address
+// The street includes the type of logradouro (ex: Avenida Ceará), abbreviated in StreetAbbreviated (ex: Av. Ceará)
 type Address struct {
-	Street       string       `json:"street" validate:"required,min=3,max=200"`
-	Number       string       `json:"number" validate:"required,min=1,max=10"`
-	Complement   string       `json:"complement" validate:"max=50"`
-	Neighborhood string       `json:"neighborhood" validate:"required,min=3,max=150"`
-	City         string       `json:"city" validate:"required,min=2,max=150"`
-	State        string       `json:"state" validate:"required,br_state"`
-	Zipcode      string       `json:"zipcode" validate:"required,cep"`
-	Coordinates  *Coordinates `json:"coordinates,omitempty" validate:"omitempty"`
+	Street            string          `json:"street" validate:"required,min=3,max=200"`
+	StreetAbbreviated string          `json:"streetAbbreviated,omitempty" validate:"max=200"`
+	Number            string          `json:"number" validate:"required,min=1,max=10"`
+	Complement        string          `json:"complement" validate:"max=50"`
+	Neighborhood      string          `json:"neighborhood" validate:"required,min=3,max=150"`
+	City              string          `json:"city" validate:"required,min=2,max=150"`
+	IBGECode          string          `json:"ibgeCode,omitempty" validate:"omitempty,len=7,numeric"`
+	State             string          `json:"state" validate:"required,br_state"`
+	Zipcode           string          `json:"zipcode" validate:"required,cep"`
+	Coordinates       *Coordinates    `json:"coordinates,omitempty" validate:"omitempty"`
+	Formatted         *AddressFormats `json:"formatted,omitempty" validate:"omitempty"`
+}
+
+// AddressFormats represents an address written in the usual formats
+type AddressFormats struct {
+	// SingleLine is the address in one line (ex: Avenida Ceará, 1234 - 7º BEC, Rio Branco - AC, 69918-111)
+	SingleLine string `json:"singleLine" validate:"required"`
+	// Label is the multi-line address of a Correios label, ending with the CEP line (ex: CEP 69918-111 Rio Branco/AC)
+	Label string `json:"label" validate:"required"`
+	// Typed is the address as users type it in free-text fields, with abbreviations and without structure
+	Typed string `json:"typed" validate:"required"`
 }
 
 // Coordinates represents geographic coordinates
@@ -20,27 +34,117 @@ type Coordinates struct {
 
 // Person represents complete fake person data
 type Person struct {
-	Name          PersonName       `json:"name" validate:"required"`
-	CPF           PersonCPF        `json:"cpf" validate:"required"`
-	RG            PersonRG         `json:"rg" validate:"required"`
-	Birthdate     string           `json:"birthdate" validate:"required,datetime=2006-01-02"`
-	Age           int              `json:"age" validate:"required,min=0,max=120"`
-	Gender        string           `json:"gender" validate:"required,oneof=male female"`
-	Height        Height           `json:"height" validate:"required"`
-	Weight        Weight           `json:"weight" validate:"required"`
-	BMI           float64          `json:"bmi" validate:"required,min=10,max=60"`
-	ZodiacSign    string           `json:"zodiacSign" validate:"required,min=3,max=20"`
-	FavoriteColor string           `json:"favoriteColor" validate:"required,min=3,max=20"`
-	BloodType     string           `json:"bloodType" validate:"required,oneof=A+ A- B+ B- AB+ AB- O+ O-"`
-	Filiation     Filiation        `json:"filiation" validate:"required"`
-	Email         PersonEmail      `json:"email" validate:"required"`
-	Phone         PersonPhone      `json:"phone" validate:"required"`
-	Address       Address          `json:"address" validate:"required"`
-	Profession    PersonProfession `json:"profession" validate:"required"`
-	Company       PersonCompany    `json:"company" validate:"required"`
-	Education     string           `json:"education" validate:"required,min=3,max=50"`
-	MaritalStatus string           `json:"maritalStatus" validate:"required,oneof=single married divorced widowed"`
-	BirthCity     string           `json:"birthCity" validate:"required,min=2,max=50"`
+	Name          PersonName          `json:"name" validate:"required"`
+	SocialName    *PersonName         `json:"socialName,omitempty" validate:"omitempty"`
+	CPF           PersonCPF           `json:"cpf" validate:"required"`
+	RG            *PersonRG           `json:"rg,omitempty" validate:"omitempty"`
+	Birthdate     string              `json:"birthdate" validate:"required,datetime=2006-01-02"`
+	Age           int                 `json:"age" validate:"min=0,max=120"`
+	Gender        string              `json:"gender" validate:"required,oneof=male female non-binary"`
+	SexAtBirth    string              `json:"sexAtBirth" validate:"required,oneof=male female"`
+	Pronouns      string              `json:"pronouns" validate:"required,oneof=ele/dele ela/dela elu/delu"`
+	Height        Height              `json:"height" validate:"required"`
+	Weight        Weight              `json:"weight" validate:"required"`
+	BMI           float64             `json:"bmi" validate:"required,min=10,max=60"`
+	ZodiacSign    string              `json:"zodiacSign" validate:"required,min=3,max=20"`
+	FavoriteColor string              `json:"favoriteColor" validate:"required,min=3,max=20"`
+	BloodType     string              `json:"bloodType" validate:"required,oneof=A+ A- B+ B- AB+ AB- O+ O-"`
+	Filiation     Filiation           `json:"filiation" validate:"required"`
+	Email         PersonEmail         `json:"email" validate:"required"`
+	Phone         PersonPhone         `json:"phone" validate:"required"`
+	Address       Address             `json:"address" validate:"required"`
+	Profession    *PersonProfession   `json:"profession,omitempty" validate:"omitempty"`
+	Company       *PersonCompany      `json:"company,omitempty" validate:"omitempty"`
+	Education     string              `json:"education" validate:"required,min=3,max=50"`
+	MaritalStatus string              `json:"maritalStatus,omitempty" validate:"omitempty,oneof=single married divorced widowed"`
+	BirthCity     string              `json:"birthCity" validate:"required,min=2,max=50"`
+	BirthState    string              `json:"birthState" validate:"required,len=2"`
+	Nationality   string              `json:"nationality" validate:"required,len=2"`
+	CreditCard    *CreditCardResponse `json:"creditCard,omitempty" validate:"omitempty"`
+	BankAccount   *PersonBankAccount  `json:"bankAccount,omitempty" validate:"omitempty"`
+	Social        *PersonSocial       `json:"social,omitempty" validate:"omitempty"`
+	// BirthCertificate and Guardian are only present for minors
+	BirthCertificate *PersonBirthCertificate `json:"birthCertificate,omitempty" validate:"omitempty"`
+	Guardian         *Guardian               `json:"guardian,omitempty" validate:"omitempty"`
+	// MarriageCertificate is only present for the couple of a household
+	MarriageCertificate *PersonMarriageCertificate `json:"marriageCertificate,omitempty" validate:"omitempty"`
+	// ForeignResident is only present for foreign nationals
+	ForeignResident *PersonForeignResident `json:"foreignResident,omitempty" validate:"omitempty"`
+	// Passport is the Brazilian passport, only present when requested for Brazilian nationals
+	Passport *PersonPassport `json:"passport,omitempty" validate:"omitempty"`
+}
+
+// PersonForeignResident represents the migration data of a foreign national living in Brazil
+type PersonForeignResident struct {
+	Nationality    string         `json:"nationality" validate:"required,min=3,max=30"`
+	CountryOfBirth string         `json:"countryOfBirth" validate:"required,min=3,max=60"`
+	EntryDate      string         `json:"entryDate" validate:"required,datetime=2006-01-02"`
+	RNM            PersonRNM      `json:"rnm" validate:"required"`
+	Passport       PersonPassport `json:"passport" validate:"required"`
+}
+
+// PersonRNM represents the national migration registry number (RNM) and its card
+// Cards issued before the Migration Law of 2017 are RNE, later ones are CRNM
+type PersonRNM struct {
+	Masked         string `json:"masked" validate:"required,len=9"`
+	Unmasked       string `json:"unmasked" validate:"required,len=8,alphanum"`
+	DocumentType   string `json:"documentType" validate:"required,oneof=RNE CRNM"`
+	ResidenceType  string `json:"residenceType" validate:"required,oneof=permanent temporary"`
+	IssueDate      string `json:"issueDate" validate:"required,datetime=2006-01-02"`
+	ExpirationDate string `json:"expirationDate" validate:"required,datetime=2006-01-02"`
+}
+
+// PersonPassport represents a passport
+// Issuer is only known for Brazilian passports
+type PersonPassport struct {
+	Number         string `json:"number" validate:"required,min=6,max=12,alphanum"`
+	Country        string `json:"country" validate:"required,len=2"`
+	Issuer         string `json:"issuer,omitempty" validate:"omitempty,min=3,max=10"`
+	IssueDate      string `json:"issueDate" validate:"required,datetime=2006-01-02"`
+	ExpirationDate string `json:"expirationDate" validate:"required,datetime=2006-01-02"`
+}
+
+// Guardian represents the legal guardian of a minor
+type Guardian struct {
+	Relationship string `json:"relationship" validate:"required,oneof=mother father"`
+	Person       Person `json:"person" validate:"required"`
+}
+
+// PersonBirthCertificate represents the birth certificate of a person
+type PersonBirthCertificate struct {
+	Number           string `json:"number" validate:"required,len=40"`
+	Unmasked         string `json:"unmasked" validate:"required,numeric,len=32"`
+	RegistryCNS      string `json:"registryCns" validate:"required,numeric,len=6"`
+	State            string `json:"state" validate:"required,br_state"`
+	RegistrationDate string `json:"registrationDate" validate:"required,datetime=2006-01-02"`
+	Book             string `json:"book" validate:"required"`
+	Page             string `json:"page" validate:"required,numeric"`
+	Term             string `json:"term" validate:"required,numeric"`
+}
+
+// PersonMarriageCertificate represents the marriage certificate of a person
+type PersonMarriageCertificate struct {
+	Number           string `json:"number" validate:"required,len=40"`
+	Unmasked         string `json:"unmasked" validate:"required,numeric,len=32"`
+	RegistryCNS      string `json:"registryCns" validate:"required,numeric,len=6"`
+	State            string `json:"state" validate:"required,br_state"`
+	RegistrationDate string `json:"registrationDate" validate:"required,datetime=2006-01-02"`
+	Book             string `json:"book" validate:"required"`
+	Page             string `json:"page" validate:"required,numeric"`
+	Term             string `json:"term" validate:"required,numeric"`
+	Spouse           string `json:"spouse" validate:"required,min=5,max=100"`
+}
+
+// Household represents a family living at the same address
+type Household struct {
+	Address Address           `json:"address" validate:"required"`
+	Members []HouseholdMember `json:"members" validate:"required,min=2,dive"`
+}
+
+// HouseholdMember represents a person of the household and their role in it
+type HouseholdMember struct {
+	Role   string `json:"role" validate:"required,oneof=head spouse child parent"`
+	Person Person `json:"person" validate:"required"`
 }
 
 // PersonName represents the full name of the person divided into parts
@@ -68,17 +172,17 @@ type PersonRG struct {
 
 // Height represents the height in different metrics
 type Height struct {
-	Centimeters float64 `json:"centimeters" validate:"required,min=50,max=250"`
-	Meters      float64 `json:"meters" validate:"required,min=0.5,max=2.5"`
-	Inches      float64 `json:"inches" validate:"required,min=19.7,max=98.4"`
-	Feet        float64 `json:"feet" validate:"required,min=1.64,max=8.2"`
+	Centimeters float64 `json:"centimeters" validate:"required,min=40,max=250"`
+	Meters      float64 `json:"meters" validate:"required,min=0.4,max=2.5"`
+	Inches      float64 `json:"inches" validate:"required,min=15.7,max=98.4"`
+	Feet        float64 `json:"feet" validate:"required,min=1.31,max=8.2"`
 }
 
 // Weight represents the weight in different metrics
 type Weight struct {
-	Kilograms float64 `json:"kilograms" validate:"required,min=30,max=300"`
-	Pounds    float64 `json:"pounds" validate:"required,min=66,max=661"`
-	Grams     float64 `json:"grams" validate:"required,min=30000,max=300000"`
+	Kilograms float64 `json:"kilograms" validate:"required,min=1,max=300"`
+	Pounds    float64 `json:"pounds" validate:"required,min=2.2,max=661"`
+	Grams     float64 `json:"grams" validate:"required,min=1000,max=300000"`
 }
 
 // Filiation represents the person's filiation
@@ -104,9 +208,40 @@ type PersonPhone struct {
 
 // PersonProfession represents the person's profession and job
 type PersonProfession struct {
-	Title  string `json:"title" validate:"required,min=3,max=50"`
-	Area   string `json:"area" validate:"required,min=3,max=50"`
-	Salary string `json:"salary,omitempty" validate:"omitempty,min=5,max=20"`
+	Title       string  `json:"title" validate:"required,min=3,max=50"`
+	Area        string  `json:"area" validate:"required,min=3,max=50"`
+	Salary      string  `json:"salary,omitempty" validate:"omitempty,min=5,max=20"`
+	SalaryValue float64 `json:"salaryValue,omitempty" validate:"omitempty,min=0"`
+	// Registration is only present for regulated professions
+	Registration *PersonCouncilRegistration `json:"registration,omitempty" validate:"omitempty"`
+}
+
+// PersonCouncilRegistration represents the registration of a regulated professional in their council
+type PersonCouncilRegistration struct {
+	Registration string `json:"registration" validate:"required,min=10,max=30"`
+	Council      string `json:"council" validate:"required,oneof=CRM OAB CREA CRC COREN"`
+	State        string `json:"state" validate:"required,br_state"`
+	Number       string `json:"number" validate:"required,numeric,min=4,max=10"`
+	Category     string `json:"category,omitempty" validate:"omitempty,max=3"`
+}
+
+// PersonBankAccount represents a bank account owned by the person
+type PersonBankAccount struct {
+	Bank        Bank   `json:"bank" validate:"required"`
+	Agency      string `json:"agency" validate:"required,min=4,max=6"`
+	Account     string `json:"account" validate:"required,min=5,max=15"`
+	AccountType string `json:"accountType" validate:"required,oneof=checking savings"`
+	HolderName  string `json:"holderName" validate:"required,min=5,max=100"`
+	HolderCPF   string `json:"holderCpf" validate:"required,cpf"`
+}
+
+// PersonSocial represents the social network handles of the person
+type PersonSocial struct {
+	Username  string `json:"username" validate:"required,min=3,max=30"`
+	Instagram string `json:"instagram" validate:"required,min=2,max=31"`
+	Twitter   string `json:"twitter" validate:"required,min=2,max=16"`
+	LinkedIn  string `json:"linkedin" validate:"required,url"`
+	GitHub    string `json:"github" validate:"required,url"`
 }
 
 // PersonCompany represents the company where the person works
@@ -115,6 +250,76 @@ type PersonCompany struct {
 	CNPJ string `json:"cnpj" validate:"required,cnpj"`
 }
 
+// StateResponse represents a state covered by the address database
+type StateResponse struct {
+	Code     string `json:"code" validate:"required,br_state"`
+	Name     string `json:"name" validate:"required,min=4,max=30"`
+	IBGECode string `json:"ibgeCode" validate:"required,len=2,numeric"`
+	Region   string `json:"region" validate:"required,min=3,max=20"`
+	DDD      string `json:"ddd" validate:"required,len=2,numeric"`
+	Cities   int    `json:"cities" validate:"min=0"`
+}
+
+// CityResponse represents a city covered by the address database
+type CityResponse struct {
+	Name      string `json:"name" validate:"required,min=2,max=150"`
+	IBGECode  string `json:"ibgeCode" validate:"required,len=7,numeric"`
+	DDD       string `json:"ddd" validate:"required,len=2,numeric"`
+	State     string `json:"state" validate:"required,br_state"`
+	Districts int    `json:"districts" validate:"min=0"`
+	Streets   int    `json:"streets" validate:"min=0"`
+}
+
+// DistrictResponse represents a district (bairro) of a city covered by the address database
+type DistrictResponse struct {
+	Name    string `json:"name" validate:"required,min=1,max=150"`
+	City    string `json:"city" validate:"required,min=2,max=150"`
+	State   string `json:"state" validate:"required,br_state"`
+	Streets int    `json:"streets" validate:"min=0"`
+}
+
+// StreetResponse represents a real street of the address database
+type StreetResponse struct {
+	Street       string       `json:"street" validate:"max=200"`
+	AddressType  string       `json:"addressType" validate:"max=30"`
+	Zipcode      string       `json:"zipcode" validate:"required,cep"`
+	Neighborhood string       `json:"neighborhood" validate:"max=150"`
+	City         string       `json:"city" validate:"required,min=2,max=150"`
+	State        string       `json:"state" validate:"required,br_state"`
+	Coordinates  *Coordinates `json:"coordinates,omitempty" validate:"omitempty"`
+}
+
+// NearbyAddressResponse represents a real street near a point, with its haversine distance from the point
+type NearbyAddressResponse struct {
+	StreetResponse
+	IBGECode   string  `json:"ibgeCode,omitempty" validate:"omitempty,len=7,numeric"`
+	DistanceKm float64 `json:"distanceKm" validate:"min=0"`
+}
+
+// NearbyAddressesResponse represents the real streets found within a radius of a point
+type NearbyAddressesResponse struct {
+	Lat       float64                 `json:"lat"`
+	Lng       float64                 `json:"lng"`
+	RadiusKm  float64                 `json:"radiusKm"`
+	Total     int                     `json:"total" validate:"min=0"`
+	Addresses []NearbyAddressResponse `json:"addresses" validate:"dive"`
+}
+
+// AutocompleteResponse represents the real streets suggested for a partial street name, best ranked first
+type AutocompleteResponse struct {
+	Query       string           `json:"query"`
+	Suggestions []StreetResponse `json:"suggestions" validate:"dive"`
+}
+
+// StreetPage represents a page of the streets of a city
+type StreetPage struct {
+	Page       int              `json:"page" validate:"min=1"`
+	PageSize   int              `json:"pageSize" validate:"min=1"`
+	Total      int              `json:"total" validate:"min=0"`
+	TotalPages int              `json:"totalPages" validate:"min=0"`
+	Streets    []StreetResponse `json:"streets" validate:"dive"`
+}
+
 // StateInfo contains information about Brazilian states
 type StateInfo struct {
 	Code string
@@ -150,6 +355,62 @@ type RGResponse struct {
 	ExpirationDate string `json:"expirationDate" validate:"required,datetime=2006-01-02"`
 }
 
+// CertificateResponse represents the response of the civil registry certificate generation
+type CertificateResponse struct {
+	Type             string `json:"type" validate:"required,oneof=birth marriage"`
+	Number           string `json:"number" validate:"required,len=40"`
+	Unmasked         string `json:"unmasked" validate:"required,numeric,len=32"`
+	RegistryCNS      string `json:"registryCns" validate:"required,numeric,len=6"`
+	State            string `json:"state" validate:"required,br_state"`
+	City             string `json:"city" validate:"required,min=2,max=50"`
+	RegistrationDate string `json:"registrationDate" validate:"required,datetime=2006-01-02"`
+	Book             string `json:"book" validate:"required"`
+	Page             string `json:"page" validate:"required,numeric"`
+	Term             string `json:"term" validate:"required,numeric"`
+	// Registrant is only present in birth certificates and Spouses in marriage certificates
+	Registrant *CertificateRegistrant `json:"registrant,omitempty" validate:"omitempty"`
+	Spouses    []CertificateSpouse    `json:"spouses,omitempty" validate:"omitempty,len=2,dive"`
+}
+
+// CertificateRegistrant represents the person registered in a birth certificate
+type CertificateRegistrant struct {
+	Name      string    `json:"name" validate:"required,min=5,max=100"`
+	Gender    string    `json:"gender" validate:"required,oneof=male female"`
+	Birthdate string    `json:"birthdate" validate:"required,datetime=2006-01-02"`
+	BirthCity string    `json:"birthCity" validate:"required,min=2,max=50"`
+	Filiation Filiation `json:"filiation" validate:"required"`
+}
+
+// CertificateSpouse represents one of the spouses of a marriage certificate
+type CertificateSpouse struct {
+	Name              string `json:"name" validate:"required,min=5,max=100"`
+	NameAfterMarriage string `json:"nameAfterMarriage" validate:"required,min=5,max=120"`
+	Gender            string `json:"gender" validate:"required,oneof=male female"`
+	Birthdate         string `json:"birthdate" validate:"required,datetime=2006-01-02"`
+	CPF               string `json:"cpf" validate:"required,cpf"`
+}
+
+// PassportResponse represents the response of the Brazilian passport generation
+type PassportResponse struct {
+	Number          string `json:"number" validate:"required,len=8,alphanum"`
+	Country         string `json:"country" validate:"required,eq=BR"`
+	Issuer          string `json:"issuer" validate:"required,eq=DPF"`
+	IssueDate       string `json:"issueDate" validate:"required,datetime=2006-01-02"`
+	ExpirationDate  string `json:"expirationDate" validate:"required,datetime=2006-01-02"`
+	HolderBirthdate string `json:"holderBirthdate" validate:"required,datetime=2006-01-02"`
+}
+
+// CouncilRegistrationResponse represents the response of the professional council registration generation
+type CouncilRegistrationResponse struct {
+	Registration string `json:"registration" validate:"required,min=10,max=30"`
+	Council      string `json:"council" validate:"required,oneof=CRM OAB CREA CRC COREN"`
+	CouncilName  string `json:"councilName" validate:"required,min=10,max=100"`
+	State        string `json:"state" validate:"required,br_state"`
+	Number       string `json:"number" validate:"required,numeric,min=4,max=10"`
+	Category     string `json:"category,omitempty" validate:"omitempty,max=3"`
+	Profession   string `json:"profession" validate:"required,min=3,max=50"`
+}
+
 // EmailResponse represents the response of the email generation
 type EmailResponse struct {
 	Email    string `json:"email" validate:"required,email"`
@@ -197,6 +458,42 @@ type ZipcodeResponse struct {
 	Unformatted string `json:"unformatted" validate:"required,cep"`
 	State       string `json:"state" validate:"required,br_state"`
 	City        string `json:"city" validate:"required,min=2,max=50"`
+	IBGECode    string `json:"ibgeCode,omitempty" validate:"omitempty,len=7,numeric"`
+	Region      string `json:"region,omitempty" validate:"max=20"`
+}
+
+// ZipcodeLookupResponse represents a real address found by its CEP
+type ZipcodeLookupResponse struct {
+	Zipcode      string       `json:"zipcode" validate:"required,cep"`
+	Unformatted  string       `json:"unformatted" validate:"required,len=8,numeric"`
+	Street       string       `json:"street" validate:"max=200"`
+	AddressType  string       `json:"addressType" validate:"max=30"`
+	Neighborhood string       `json:"neighborhood" validate:"max=150"`
+	City         string       `json:"city" validate:"required,min=2,max=150"`
+	IBGECode     string       `json:"ibgeCode" validate:"omitempty,len=7,numeric"`
+	State        string       `json:"state" validate:"required,br_state"`
+	StateName    string       `json:"stateName" validate:"required,min=4,max=30"`
+	Region       string       `json:"region" validate:"max=20"`
+	DDD          string       `json:"ddd" validate:"omitempty,len=2,numeric"`
+	Coordinates  *Coordinates `json:"coordinates,omitempty" validate:"omitempty"`
+}
+
+// ViaCEPResponse represents a CEP in the format of the ViaCEP web service (viacep.com.br)
+// Every field is present, empty when unknown, as in the original service
+type ViaCEPResponse struct {
+	CEP         string `json:"cep"`
+	Logradouro  string `json:"logradouro"`
+	Complemento string `json:"complemento"`
+	Unidade     string `json:"unidade"`
+	Bairro      string `json:"bairro"`
+	Localidade  string `json:"localidade"`
+	UF          string `json:"uf"`
+	Estado      string `json:"estado"`
+	Regiao      string `json:"regiao"`
+	IBGE        string `json:"ibge"`
+	GIA         string `json:"gia"`
+	DDD         string `json:"ddd"`
+	SIAFI       string `json:"siafi"`
 }
 
 // CompanyResponse represents the response of the company generation
@@ -206,11 +503,104 @@ type CompanyResponse struct {
 	CNPJ              string  `json:"cnpj" validate:"required,cnpj"`
 	StateRegistration string  `json:"stateRegistration" validate:"required,min=9,max=14"`
 	Email             string  `json:"email" validate:"required,email"`
+	Domain            string  `json:"domain" validate:"required,fqdn"`
+	Phone             string  `json:"phone" validate:"required,br_phone"`
+	FoundedAt         string  `json:"foundedAt" validate:"required,datetime=2006-01-02"`
+	Address           Address `json:"address" validate:"required"`
+	// Registration data of the company at the Receita Federal
+	LegalNature         CompanyLegalNature `json:"legalNature" validate:"required"`
+	Size                string             `json:"size" validate:"required,oneof=ME EPP DEMAIS"`
+	ShareCapital        string             `json:"shareCapital" validate:"required,min=5,max=25"`
+	ShareCapitalValue   float64            `json:"shareCapitalValue" validate:"required,gt=0"`
+	SimplesNacional     bool               `json:"simplesNacional"`
+	Status              string             `json:"status" validate:"required,oneof=ATIVA SUSPENSA INAPTA BAIXADA"`
+	StatusDate          string             `json:"statusDate" validate:"required,datetime=2006-01-02"`
+	MainActivity        CompanyActivity    `json:"mainActivity" validate:"required"`
+	SecondaryActivities []CompanyActivity  `json:"secondaryActivities" validate:"dive"`
+	Partners            []CompanyPartner   `json:"partners" validate:"required,min=1,dive"`
+}
+
+// CompanyEmployeesResponse represents the response of the employee roster generation of a company
+type CompanyEmployeesResponse struct {
+	Company   CompanyResponse   `json:"company" validate:"required"`
+	Employees []CompanyEmployee `json:"employees" validate:"required,dive"`
+}
+
+// CompanyEmployee represents an employee of a company
+// The job title, department and salary are the profession of the person, who works at the company
+type CompanyEmployee struct {
+	EmployeeID     string  `json:"employeeId" validate:"required,len=6,numeric"`
+	CorporateEmail string  `json:"corporateEmail" validate:"required,email"`
+	JobTitle       string  `json:"jobTitle" validate:"required,min=3,max=50"`
+	Department     string  `json:"department" validate:"required,min=3,max=50"`
+	AdmissionDate  string  `json:"admissionDate" validate:"required,datetime=2006-01-02"`
+	Salary         string  `json:"salary" validate:"required,min=5,max=20"`
+	SalaryValue    float64 `json:"salaryValue" validate:"required,gt=0"`
+	Person         Person  `json:"person" validate:"required"`
+}
+
+// CompanyGroupResponse represents the response of the company group generation
+// The headquarters (matriz) and the branches (filiais) share the root of the CNPJ
+type CompanyGroupResponse struct {
+	Root         string          `json:"root" validate:"required,len=8,numeric"`
+	Headquarters CompanyResponse `json:"headquarters" validate:"required"`
+	Branches     []CompanyBranch `json:"branches" validate:"required,min=1,dive"`
+}
+
+// CompanyBranch represents a branch (filial) of a company, with its own CNPJ, state registration and address
+type CompanyBranch struct {
+	CNPJ              string  `json:"cnpj" validate:"required,cnpj"`
+	Branch            string  `json:"branch" validate:"required,len=4,numeric"`
+	StateRegistration string  `json:"stateRegistration" validate:"required,min=9,max=14"`
 	Phone             string  `json:"phone" validate:"required,br_phone"`
 	FoundedAt         string  `json:"foundedAt" validate:"required,datetime=2006-01-02"`
+	Status            string  `json:"status" validate:"required,oneof=ATIVA SUSPENSA INAPTA BAIXADA"`
+	StatusDate        string  `json:"statusDate" validate:"required,datetime=2006-01-02"`
 	Address           Address `json:"address" validate:"required"`
 }
 
+// MEIResponse represents the response of the MEI (microempreendedor individual) generation
+// The company is registered in the name of the owner and shares their address and contacts
+type MEIResponse struct {
+	Name               string             `json:"name" validate:"required,min=15,max=120"`
+	TradeName          string             `json:"tradeName" validate:"required,min=3,max=100"`
+	CNPJ               string             `json:"cnpj" validate:"required,cnpj"`
+	LegalNature        CompanyLegalNature `json:"legalNature" validate:"required"`
+	OpenedAt           string             `json:"openedAt" validate:"required,datetime=2006-01-02"`
+	Status             string             `json:"status" validate:"required,eq=ATIVA"`
+	SimeiOptant        bool               `json:"simeiOptant"`
+	MainActivity       CompanyActivity    `json:"mainActivity" validate:"required"`
+	AnnualRevenue      string             `json:"annualRevenue" validate:"required,min=5,max=20"`
+	AnnualRevenueValue float64            `json:"annualRevenueValue" validate:"required,gt=0,lte=81000"`
+	Email              string             `json:"email" validate:"required,email"`
+	Phone              string             `json:"phone" validate:"required,br_phone"`
+	Address            Address            `json:"address" validate:"required"`
+	Owner              Person             `json:"owner" validate:"required"`
+}
+
+// CompanyLegalNature represents the legal nature (natureza jurídica) of a company
+type CompanyLegalNature struct {
+	Code        string `json:"code" validate:"required,len=5"`
+	Description string `json:"description" validate:"required,min=3,max=100"`
+}
+
+// CompanyActivity represents an economic activity of the CNAE
+type CompanyActivity struct {
+	Code        string `json:"code" validate:"required,len=9"`
+	Description string `json:"description" validate:"required,min=3,max=150"`
+}
+
+// CompanyPartner represents a partner or administrator listed in the QSA of a company
+// Share is only present for companies whose capital is divided in quotas
+type CompanyPartner struct {
+	Name              string  `json:"name" validate:"required,min=3,max=100"`
+	CPF               string  `json:"cpf" validate:"required,cpf"`
+	QualificationCode string  `json:"qualificationCode" validate:"required,len=2,numeric"`
+	Qualification     string  `json:"qualification" validate:"required,min=3,max=100"`
+	EntryDate         string  `json:"entryDate" validate:"required,datetime=2006-01-02"`
+	Share             float64 `json:"share,omitempty" validate:"omitempty,gt=0,lte=100"`
+}
+
 // CPFValidationResponse represents the response of the CPF validation
 type CPFValidationResponse struct {
 	CPF   string `json:"cpf" validate:"required"`
@@ -218,9 +608,13 @@ type CPFValidationResponse struct {
 }
 
 // CNPJValidationResponse represents the response of the CNPJ validation
+// The root and the branch are only present when it is valid
 type CNPJValidationResponse struct {
-	CNPJ  string `json:"cnpj" validate:"required"`
-	Valid bool   `json:"valid" validate:"required"`
+	CNPJ         string `json:"cnpj" validate:"required"`
+	Valid        bool   `json:"valid" validate:"required"`
+	Root         string `json:"root,omitempty"`
+	Branch       string `json:"branch,omitempty"`
+	Headquarters *bool  `json:"headquarters,omitempty"`
 }
 
 // RGValidationResponse represents the response of the RG validation
@@ -229,6 +623,66 @@ type RGValidationResponse struct {
 	Valid bool   `json:"valid" validate:"required"`
 }
 
+// PassportValidationResponse represents the response of the passport validation
+type PassportValidationResponse struct {
+	Passport string `json:"passport" validate:"required"`
+	Valid    bool   `json:"valid" validate:"required"`
+}
+
+// CouncilRegistrationValidationResponse represents the response of the professional council registration validation
+// The parts of the registration are only present when it is valid
+type CouncilRegistrationValidationResponse struct {
+	Registration string `json:"registration" validate:"required"`
+	Valid        bool   `json:"valid"`
+	Reason       string `json:"reason,omitempty"`
+	Formatted    string `json:"formatted,omitempty"`
+	Council      string `json:"council,omitempty"`
+	State        string `json:"state,omitempty"`
+	Number       string `json:"number,omitempty"`
+	Category     string `json:"category,omitempty"`
+}
+
+// CertificateValidationResponse represents the response of the civil registry certificate validation
+// The parts of the number are only present when it is valid
+type CertificateValidationResponse struct {
+	Number      string `json:"number" validate:"required"`
+	Valid       bool   `json:"valid"`
+	Reason      string `json:"reason,omitempty"`
+	Formatted   string `json:"formatted,omitempty"`
+	Type        string `json:"type,omitempty"`
+	RegistryCNS string `json:"registryCns,omitempty"`
+	Year        int    `json:"year,omitempty"`
+	Book        int    `json:"book,omitempty"`
+	Page        int    `json:"page,omitempty"`
+	Term        int    `json:"term,omitempty"`
+}
+
+// AddressValidationRequest represents an address submitted for consistency validation
+// The fields have the names of Address, so generated addresses can be submitted as they are
+type AddressValidationRequest struct {
+	Zipcode string `json:"zipcode"`
+	City    string `json:"city"`
+	State   string `json:"state"`
+	// Phone is optional; when present its DDD is checked against the DDD of the city
+	Phone string `json:"phone"`
+}
+
+// AddressValidationFinding represents the result of the check of a field of an address
+// Expected holds the value consistent with the other fields when the field is inconsistent
+type AddressValidationFinding struct {
+	Field    string `json:"field"`
+	Valid    bool   `json:"valid"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Expected string `json:"expected,omitempty"`
+}
+
+// AddressValidationResponse represents the response of the address validation, valid when every finding is
+type AddressValidationResponse struct {
+	Valid    bool                       `json:"valid"`
+	Findings []AddressValidationFinding `json:"findings"`
+}
+
 // PhoneValidationResponse represents the response of the phone validation
 type PhoneValidationResponse struct {
 	Valid               bool   `json:"valid" validate:"required"`